@@ -0,0 +1,18 @@
+package av
+
+import (
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/cache"
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// NewMemoryCache returns an in-memory LRU cache holding at most maxEntries
+// responses. A maxEntries of zero or less means no limit.
+func NewMemoryCache(maxEntries int) types.Cache {
+	return cache.NewLRU(maxEntries)
+}
+
+// NewFileCache returns a cache that persists responses as files under dir,
+// creating the directory if needed.
+func NewFileCache(dir string) (types.Cache, error) {
+	return cache.NewFile(dir)
+}
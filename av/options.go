@@ -0,0 +1,78 @@
+package av
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal"
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// Option configures optional client behavior. Options are applied in order,
+// so later options override earlier ones.
+type Option func(*internal.Config)
+
+// WithHTTPClient sets the HTTP client used for requests. Defaults to http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(cfg *internal.Config) {
+		cfg.HTTPClient = httpClient
+	}
+}
+
+// WithBaseURL overrides the Alpha Vantage query endpoint, e.g. to route through
+// a proxy or point at a local stub server.
+func WithBaseURL(baseURL string) Option {
+	return func(cfg *internal.Config) {
+		cfg.BaseURL = baseURL
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(cfg *internal.Config) {
+		cfg.UserAgent = userAgent
+	}
+}
+
+// WithTimeout bounds each HTTP attempt. Unlike http.Client.Timeout it applies
+// per attempt, so a timed-out attempt can still be retried by the retry policy.
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *internal.Config) {
+		cfg.Timeout = timeout
+	}
+}
+
+// WithRateLimit enables the client-side token-bucket limiter. The limiter is
+// shared by every service and goroutine using the returned client.
+func WithRateLimit(limit types.RateLimit) Option {
+	return func(cfg *internal.Config) {
+		cfg.RateLimit = limit
+	}
+}
+
+// WithRetryPolicy retries throttle notices and transient HTTP failures
+// according to policy. See types.DefaultRetryPolicy for a sensible starting point.
+func WithRetryPolicy(policy types.RetryPolicy) Option {
+	return func(cfg *internal.Config) {
+		cfg.Retry = policy
+	}
+}
+
+// WithCache serves repeated requests from cache while they are fresh according
+// to policy. Cache hits never reach the network or the rate limiter.
+// See types.DefaultCachePolicy, NewMemoryCache and NewFileCache.
+func WithCache(cache types.Cache, policy types.CachePolicy) Option {
+	return func(cfg *internal.Config) {
+		cfg.Cache = cache
+		cfg.CachePolicy = policy
+	}
+}
+
+// WithLogger logs each request attempt at debug level and retries at warn
+// level. The API key is never logged.
+func WithLogger(logger *slog.Logger) Option {
+	return func(cfg *internal.Config) {
+		cfg.Logger = logger
+	}
+}
@@ -0,0 +1,115 @@
+package av_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/av"
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+const quoteFixture = `{"Global Quote": {"01. symbol": "IBM", "05. price": "105.0"}}`
+
+func TestWithBaseURLAndUserAgent_AreSentToServer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/proxy/query" {
+			t.Errorf("expected path /proxy/query, got %q", r.URL.Path)
+		}
+		if ua := r.Header.Get("User-Agent"); ua != "portfolio-bot/1.0" {
+			t.Errorf("expected user agent portfolio-bot/1.0, got %q", ua)
+		}
+		if r.URL.Query().Get("function") != "GLOBAL_QUOTE" {
+			t.Errorf("expected function GLOBAL_QUOTE, got %q", r.URL.Query().Get("function"))
+		}
+		w.Write([]byte(quoteFixture))
+	}))
+	defer srv.Close()
+
+	cli := av.NewClient("test-key",
+		av.WithBaseURL(srv.URL+"/proxy/query"),
+		av.WithUserAgent("portfolio-bot/1.0"),
+	)
+
+	quote, err := cli.CoreStocks().Quote("IBM")
+	if err != nil {
+		t.Fatalf("Quote returned error: %v", err)
+	}
+	if quote.Symbol != "IBM" {
+		t.Fatalf("expected IBM, got %q", quote.Symbol)
+	}
+}
+
+func TestWithTimeout_AppliesPerAttemptAndRetries(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		w.Write([]byte(quoteFixture))
+	}))
+	defer srv.Close()
+
+	cli := av.NewClient("test-key",
+		av.WithBaseURL(srv.URL),
+		av.WithTimeout(50*time.Millisecond),
+		av.WithRetryPolicy(types.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}),
+	)
+
+	if _, err := cli.CoreStocks().Quote("IBM"); err != nil {
+		t.Fatalf("Quote returned error: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestWithTimeout_SurfacesDeadlineExceeded(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	cli := av.NewClient("test-key", av.WithBaseURL(srv.URL), av.WithTimeout(20*time.Millisecond))
+	if _, err := cli.CoreStocks().Quote("IBM"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestWithLogger_NeverLogsAPIKey(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	cli := av.NewClient("secret-key",
+		av.WithBaseURL("http://127.0.0.1:1/query"),
+		av.WithLogger(logger),
+		av.WithRetryPolicy(types.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, RetryOn: types.RetryNetwork}),
+	)
+
+	_, err := cli.CoreStocks().Quote("IBM")
+	if err == nil {
+		t.Fatalf("expected connection error")
+	}
+	if strings.Contains(err.Error(), "secret-key") {
+		t.Fatalf("error leaks API key: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "function=GLOBAL_QUOTE") || !strings.Contains(out, "retrying") {
+		t.Fatalf("expected request and retry log lines, got:\n%s", out)
+	}
+	if strings.Contains(out, "secret-key") {
+		t.Fatalf("log output leaks API key:\n%s", out)
+	}
+}
@@ -7,10 +7,18 @@ import (
 	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
 )
 
-func NewClient(apiKey string) types.Client {
-	return internal.NewClient(apiKey, nil)
+// NewClient returns an Alpha Vantage client for apiKey configured by opts.
+func NewClient(apiKey string, opts ...Option) types.Client {
+	cfg := internal.Config{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	return internal.NewClient(apiKey, cfg)
 }
 
-func NewClientWithHTTPClient(apiKey string, httpClient *http.Client) types.Client {
-	return internal.NewClient(apiKey, httpClient)
+// NewClientWithHTTPClient is shorthand for NewClient(apiKey, WithHTTPClient(httpClient), opts...).
+func NewClientWithHTTPClient(apiKey string, httpClient *http.Client, opts ...Option) types.Client {
+	return NewClient(apiKey, append([]Option{WithHTTPClient(httpClient)}, opts...)...)
 }
@@ -2,13 +2,18 @@ package av_test
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/av"
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/avtest"
 	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
 )
 
@@ -464,3 +469,1115 @@ func TestTechnicalIndicators_SMA_SendsExpectedQueryAndParsesResponse(t *testing.
 		t.Fatalf("expected 1 indicator value, got %d", len(resp.IndicatorValues))
 	}
 }
+
+func TestCoreStocks_QuoteContext_CancelledContextAbortsRequest(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	_, err := cli.CoreStocks().QuoteContext(ctx, "IBM")
+	if err == nil {
+		t.Fatalf("expected error for cancelled context")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestTechnicalIndicators_SMAContext_CancelledContextAbortsRequest(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	_, err := cli.TechnicalIndicators().SMAContext(ctx, types.IndicatorParams{
+		Symbol:     "IBM",
+		Interval:   "daily",
+		TimePeriod: 20,
+		SeriesType: "close",
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestWithRateLimit_FailFastSurfacesErrRateLimitExceeded(t *testing.T) {
+	fixture := []byte(`{"Global Quote": {"01. symbol": "IBM", "05. price": "105.0"}}`)
+
+	calls := 0
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClient("test-key", av.WithHTTPClient(httpClient), av.WithRateLimit(types.RateLimit{
+		RequestsPerMinute: 1,
+		Policy:            types.RateLimitFailFast,
+	}))
+
+	if _, err := cli.CoreStocks().Quote("IBM"); err != nil {
+		t.Fatalf("first Quote returned error: %v", err)
+	}
+	if _, err := cli.FundamentalData().Dividends("IBM"); !errors.Is(err, types.ErrRateLimitExceeded) {
+		t.Fatalf("expected ErrRateLimitExceeded from a second service, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 HTTP call, got %d", calls)
+	}
+}
+
+func TestErrors_ClassifyValidationAPIAndDecodeFailures(t *testing.T) {
+	var body string
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+
+	_, err := cli.CoreStocks().Quote("  ")
+	var paramErr *types.ParameterError
+	if !errors.Is(err, types.ErrInvalidParameter) || !errors.As(err, &paramErr) {
+		t.Fatalf("expected ParameterError, got %v", err)
+	}
+	if paramErr.Function != "GLOBAL_QUOTE" || paramErr.Parameter != "symbol" {
+		t.Fatalf("unexpected parameter error fields: %+v", paramErr)
+	}
+
+	body = `{"Error Message": "Invalid API call. Please retry or visit the documentation (https://www.alphavantage.co/documentation/) for TIME_SERIES_DAILY."}`
+	_, err = cli.CoreStocks().Daily(types.TimeSeriesParams{Symbol: "NOPE"})
+	var apiErr *types.APIError
+	if !errors.Is(err, types.ErrInvalidAPICall) || !errors.As(err, &apiErr) {
+		t.Fatalf("expected invalid API call error, got %v", err)
+	}
+	if apiErr.Function != "TIME_SERIES_DAILY" || apiErr.Key != "Error Message" {
+		t.Fatalf("unexpected API error fields: %+v", apiErr)
+	}
+
+	body = `{"Information": "Thank you for using Alpha Vantage! This is a premium endpoint."}`
+	if _, err := cli.FundamentalData().ETFProfile("QQQ"); !errors.Is(err, types.ErrPremiumRequired) {
+		t.Fatalf("expected ErrPremiumRequired, got %v", err)
+	}
+
+	body = `{"symbol": "IBM", "data": "not-a-list"}`
+	_, err = cli.FundamentalData().Dividends("IBM")
+	var decodeErr *types.DecodeError
+	if !errors.Is(err, types.ErrDecode) || !errors.As(err, &decodeErr) {
+		t.Fatalf("expected DecodeError, got %v", err)
+	}
+	if decodeErr.Function != "DIVIDENDS" || decodeErr.Err == nil {
+		t.Fatalf("unexpected decode error fields: %+v", decodeErr)
+	}
+}
+
+func TestOptionsData_HistoricalOptions_SendsExpectedQueryAndParsesResponse(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/historical_options_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("function") != "HISTORICAL_OPTIONS" {
+				return nil, fmt.Errorf("expected function HISTORICAL_OPTIONS, got %q", q.Get("function"))
+			}
+			if q.Get("symbol") != "IBM" {
+				return nil, fmt.Errorf("expected symbol IBM, got %q", q.Get("symbol"))
+			}
+			if q.Get("date") != "2024-12-13" {
+				return nil, fmt.Errorf("expected date 2024-12-13, got %q", q.Get("date"))
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.OptionsData().HistoricalOptions(types.HistoricalOptionsParams{
+		Symbol: "IBM",
+		Date:   time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("HistoricalOptions returned error: %v", err)
+	}
+	if len(resp.Data.Calls()) != 3 {
+		t.Fatalf("expected 3 calls, got %d", len(resp.Data.Calls()))
+	}
+}
+
+func TestOptionsData_RealtimeOptions_SendsContractAndGreeks(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("function") != "REALTIME_OPTIONS" {
+				return nil, fmt.Errorf("expected function REALTIME_OPTIONS, got %q", q.Get("function"))
+			}
+			if q.Get("contract") != "IBM250117C00200000" {
+				return nil, fmt.Errorf("expected contract IBM250117C00200000, got %q", q.Get("contract"))
+			}
+			if q.Get("require_greeks") != "true" {
+				return nil, fmt.Errorf("expected require_greeks true, got %q", q.Get("require_greeks"))
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(`{"endpoint": "Realtime Options", "message": "success", "data": []}`))),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.OptionsData().RealtimeOptions(types.RealtimeOptionsParams{
+		Symbol:        "IBM",
+		Contract:      "IBM250117C00200000",
+		RequireGreeks: true,
+	})
+	if err != nil {
+		t.Fatalf("RealtimeOptions returned error: %v", err)
+	}
+	if resp.Endpoint != "Realtime Options" {
+		t.Fatalf("unexpected endpoint %q", resp.Endpoint)
+	}
+}
+
+func TestCommodities_WTI_SendsIntervalAndParsesSeries(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/commodity_WTI_daily.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("function") != "WTI" {
+				return nil, fmt.Errorf("expected function WTI, got %q", q.Get("function"))
+			}
+			if q.Get("interval") != "daily" {
+				return nil, fmt.Errorf("expected interval daily, got %q", q.Get("interval"))
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	series, err := cli.Commodities().WTI(types.CommodityParams{Interval: types.SeriesDaily})
+	if err != nil {
+		t.Fatalf("WTI returned error: %v", err)
+	}
+	if len(series.Observations()) != 3 {
+		t.Fatalf("expected 3 observations, got %d", len(series.Observations()))
+	}
+}
+
+func TestCommodities_RejectsUnsupportedInterval(t *testing.T) {
+	cli := av.NewClientWithHTTPClient("test-key", &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("unexpected request to %s", req.URL)
+		}),
+	})
+
+	_, err := cli.Commodities().Copper(types.CommodityParams{Interval: types.SeriesDaily})
+	if !errors.Is(err, types.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter, got %v", err)
+	}
+}
+
+func TestEconomicIndicators_TreasuryYield_SendsMaturityAndParsesSeries(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/treasury_yield_monthly.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("function") != "TREASURY_YIELD" {
+				return nil, fmt.Errorf("expected function TREASURY_YIELD, got %q", q.Get("function"))
+			}
+			if q.Get("interval") != "monthly" {
+				return nil, fmt.Errorf("expected interval monthly, got %q", q.Get("interval"))
+			}
+			if q.Get("maturity") != "10year" {
+				return nil, fmt.Errorf("expected maturity 10year, got %q", q.Get("maturity"))
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	series, err := cli.EconomicIndicators().TreasuryYield(types.TreasuryYieldParams{
+		Interval: types.SeriesMonthly,
+		Maturity: types.Maturity10Year,
+	})
+	if err != nil {
+		t.Fatalf("TreasuryYield returned error: %v", err)
+	}
+	if series.Unit != "percent" || len(series.Data) != 4 {
+		t.Fatalf("unexpected series: %+v", series)
+	}
+	if series.Data[1].Valid {
+		t.Fatalf("expected October 2024 to be missing, got %+v", series.Data[1])
+	}
+	if latest, ok := series.Latest(); !ok || latest.Value != 4.39 {
+		t.Fatalf("unexpected latest point: %+v, %v", latest, ok)
+	}
+}
+
+func TestEconomicIndicators_RejectsInvalidParameters(t *testing.T) {
+	cli := av.NewClientWithHTTPClient("test-key", &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("unexpected request to %s", req.URL)
+		}),
+	})
+
+	if _, err := cli.EconomicIndicators().TreasuryYield(types.TreasuryYieldParams{Maturity: "1year"}); !errors.Is(err, types.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter for maturity, got %v", err)
+	}
+	if _, err := cli.EconomicIndicators().CPI(types.EconomicIndicatorParams{Interval: types.SeriesDaily}); !errors.Is(err, types.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter for interval, got %v", err)
+	}
+}
+
+func TestForex_Daily_SendsExpectedQueryAndParsesResponse(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/fx_daily_EURUSD.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("function") != "FX_DAILY" {
+				return nil, fmt.Errorf("expected function FX_DAILY, got %q", q.Get("function"))
+			}
+			if q.Get("from_symbol") != "EUR" || q.Get("to_symbol") != "USD" {
+				return nil, fmt.Errorf("unexpected pair %q/%q", q.Get("from_symbol"), q.Get("to_symbol"))
+			}
+			if q.Get("outputsize") != "full" {
+				return nil, fmt.Errorf("expected outputsize full, got %q", q.Get("outputsize"))
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.Forex().Daily(types.ForexDailyParams{FromSymbol: "EUR", ToSymbol: "USD", OutputSize: "full"})
+	if err != nil {
+		t.Fatalf("Daily returned error: %v", err)
+	}
+	if len(resp.TimeSeries) != 3 {
+		t.Fatalf("expected 3 bars, got %d", len(resp.TimeSeries))
+	}
+}
+
+func TestAlphaInteligence_NewsSentiment_EncodesParameters(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/news_sentiment_AAPL.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			want := map[string]string{
+				"function":  "NEWS_SENTIMENT",
+				"tickers":   "AAPL,CRYPTO:BTC",
+				"topics":    "technology,earnings",
+				"time_from": "20241029T0930",
+				"time_to":   "20241031T1600",
+				"sort":      "RELEVANCE",
+				"limit":     "200",
+			}
+			for key, value := range want {
+				if q.Get(key) != value {
+					return nil, fmt.Errorf("expected %s=%q, got %q", key, value, q.Get(key))
+				}
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.AlphaInteligence().NewsSentiment(types.NewsSentimentParams{
+		Tickers:  []string{"AAPL", " CRYPTO:BTC "},
+		Topics:   []types.NewsTopic{types.TopicTechnology, types.TopicEarnings},
+		TimeFrom: time.Date(2024, 10, 29, 9, 30, 45, 0, time.UTC),
+		TimeTo:   time.Date(2024, 10, 31, 16, 0, 0, 0, time.UTC),
+		Sort:     types.NewsSortRelevance,
+		Limit:    200,
+	})
+	if err != nil {
+		t.Fatalf("NewsSentiment returned error: %v", err)
+	}
+	if len(resp.Feed) != 2 {
+		t.Fatalf("expected 2 articles, got %d", len(resp.Feed))
+	}
+}
+
+func TestAlphaInteligence_NewsSentiment_ConvertsTimesToUTC(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/news_sentiment_AAPL.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("time_from") != "20241029T1330" {
+				return nil, fmt.Errorf("expected time_from 20241029T1330, got %q", q.Get("time_from"))
+			}
+			if q.Get("time_to") != "20241031T2000" {
+				return nil, fmt.Errorf("expected time_to 20241031T2000, got %q", q.Get("time_to"))
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	edt := time.FixedZone("EDT", -4*60*60)
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	_, err = cli.AlphaInteligence().NewsSentiment(types.NewsSentimentParams{
+		Tickers:  []string{"AAPL"},
+		TimeFrom: time.Date(2024, 10, 29, 9, 30, 0, 0, edt),
+		TimeTo:   time.Date(2024, 10, 31, 16, 0, 0, 0, edt),
+	})
+	if err != nil {
+		t.Fatalf("NewsSentiment returned error: %v", err)
+	}
+}
+
+func TestAlphaInteligence_TopGainersLosers_ParsesResponse(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/top_gainers_losers.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if fn := req.URL.Query().Get("function"); fn != "TOP_GAINERS_LOSERS" {
+				return nil, fmt.Errorf("expected function TOP_GAINERS_LOSERS, got %q", fn)
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.AlphaInteligence().TopGainersLosers()
+	if err != nil {
+		t.Fatalf("TopGainersLosers returned error: %v", err)
+	}
+	if resp.MostActivelyTraded[0].Ticker != "NVDA" || resp.MostActivelyTraded[0].ChangePercentage != -2.3274 {
+		t.Fatalf("unexpected most active entry: %+v", resp.MostActivelyTraded[0])
+	}
+}
+
+func TestAlphaInteligence_InsiderTransactions_SendsSymbolAndParsesResponse(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/insider_transactions_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("function") != "INSIDER_TRANSACTIONS" {
+				return nil, fmt.Errorf("expected function INSIDER_TRANSACTIONS, got %q", q.Get("function"))
+			}
+			if q.Get("symbol") != "IBM" {
+				return nil, fmt.Errorf("expected symbol IBM, got %q", q.Get("symbol"))
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.AlphaInteligence().InsiderTransactions(types.InsiderTransactionsParams{Symbol: "IBM"})
+	if err != nil {
+		t.Fatalf("InsiderTransactions returned error: %v", err)
+	}
+	if len(resp.Data) != 5 {
+		t.Fatalf("expected 5 transactions, got %d", len(resp.Data))
+	}
+}
+
+func TestAlphaInteligence_EarningsCallTranscriptRange_SkipsMissingQuarters(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	srv.Handle("EARNINGS_CALL_TRANSCRIPT",
+		avtest.MustFixture("../models/testdata/earnings_call_transcript_IBM.json"),
+		avtest.JSON(`{"symbol": "IBM", "quarter": "2024Q2", "transcript": []}`),
+		avtest.JSON(`{"symbol": "IBM", "quarter": "2024Q3", "transcript": []}`),
+		avtest.MustFixture("../models/testdata/earnings_call_transcript_IBM.json"),
+	)
+
+	cli := av.NewClient("test-key", av.WithBaseURL(srv.URL()))
+	transcripts, err := cli.AlphaInteligence().EarningsCallTranscriptRange(types.EarningsCallTranscriptRangeParams{
+		Symbol: "IBM",
+		From:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("EarningsCallTranscriptRange returned error: %v", err)
+	}
+	if len(transcripts) != 2 {
+		t.Fatalf("expected 2 transcripts, got %d", len(transcripts))
+	}
+
+	var quarters []string
+	for _, q := range srv.Requests() {
+		quarters = append(quarters, q.Get("quarter"))
+	}
+	want := []string{"2024Q1", "2024Q2", "2024Q3", "2024Q4"}
+	if len(quarters) != len(want) {
+		t.Fatalf("expected requests for %v, got %v", want, quarters)
+	}
+	for i := range want {
+		if quarters[i] != want[i] {
+			t.Fatalf("expected requests for %v, got %v", want, quarters)
+		}
+	}
+}
+
+func TestAlphaInteligence_EarningsCallTranscriptRange_ReturnsAPIErrors(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	srv.Handle("EARNINGS_CALL_TRANSCRIPT", avtest.InvalidCall())
+
+	cli := av.NewClient("test-key", av.WithBaseURL(srv.URL()))
+	transcripts, err := cli.AlphaInteligence().EarningsCallTranscriptRange(types.EarningsCallTranscriptRangeParams{
+		Symbol: "NOTASYMBOL",
+		From:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if !errors.Is(err, types.ErrInvalidAPICall) {
+		t.Fatalf("expected ErrInvalidAPICall, got %v", err)
+	}
+	if len(transcripts) != 0 {
+		t.Fatalf("expected no transcripts, got %d", len(transcripts))
+	}
+	if got := srv.Count("EARNINGS_CALL_TRANSCRIPT"); got != 1 {
+		t.Fatalf("expected the range to stop after the first error, got %d requests", got)
+	}
+}
+
+func TestAlphaInteligence_EarningsCallTranscript_ValidatesQuarter(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+
+	cli := av.NewClient("test-key", av.WithBaseURL(srv.URL()))
+	_, err := cli.AlphaInteligence().EarningsCallTranscript(types.EarningsCallTranscriptParams{Symbol: "IBM", Quarter: "2024Q5"})
+	if !errors.Is(err, types.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter, got %v", err)
+	}
+	if srv.Count("EARNINGS_CALL_TRANSCRIPT") != 0 {
+		t.Fatal("expected no request for an invalid quarter")
+	}
+}
+
+func TestFundamentalData_Earnings_SendsSymbolAndParsesResponse(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/earnings_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("function") != "EARNINGS" {
+				return nil, fmt.Errorf("expected function EARNINGS, got %q", q.Get("function"))
+			}
+			if q.Get("symbol") != "IBM" {
+				return nil, fmt.Errorf("expected symbol IBM, got %q", q.Get("symbol"))
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.FundamentalData().Earnings("IBM")
+	if err != nil {
+		t.Fatalf("Earnings returned error: %v", err)
+	}
+	if len(resp.QuarterlyEarnings) != 3 || resp.QuarterlyEarnings[2].EstimatedEPS.Valid {
+		t.Fatalf("unexpected quarterly earnings: %+v", resp.QuarterlyEarnings)
+	}
+}
+
+func TestFundamentalData_ListingStatus_SendsDateAndState(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/listing_status_delisted.csv")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("function") != "LISTING_STATUS" {
+				return nil, fmt.Errorf("expected function LISTING_STATUS, got %q", q.Get("function"))
+			}
+			if q.Get("date") != "2024-06-28" || q.Get("state") != "delisted" {
+				return nil, fmt.Errorf("unexpected date/state %q/%q", q.Get("date"), q.Get("state"))
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     http.Header{"Content-Type": {"application/x-download"}},
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.FundamentalData().ListingStatus(types.ListingStatusParams{
+		Date:  time.Date(2024, 6, 28, 0, 0, 0, 0, time.UTC),
+		State: types.ListingDelisted,
+	})
+	if err != nil {
+		t.Fatalf("ListingStatus returned error: %v", err)
+	}
+	if len(resp.Listings) != 3 {
+		t.Fatalf("expected 3 listings, got %d", len(resp.Listings))
+	}
+
+	_, err = cli.FundamentalData().ListingStatus(types.ListingStatusParams{Date: time.Date(2009, 12, 31, 0, 0, 0, 0, time.UTC)})
+	if !errors.Is(err, types.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter for a pre-2010 date, got %v", err)
+	}
+}
+
+func TestFundamentalData_EarningsCalendar_SendsHorizon(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/earnings_calendar_IBM.csv")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("function") != "EARNINGS_CALENDAR" || q.Get("horizon") != "12month" || q.Get("symbol") != "IBM" {
+				return nil, fmt.Errorf("unexpected query %s", req.URL.RawQuery)
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.FundamentalData().EarningsCalendar(types.EarningsCalendarParams{Symbol: "IBM", Horizon: types.Horizon12Month})
+	if err != nil {
+		t.Fatalf("EarningsCalendar returned error: %v", err)
+	}
+	if len(resp.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(resp.Entries))
+	}
+}
+
+func TestCoreStocks_BulkQuotes_ChunksSymbolsAndReportsMissing(t *testing.T) {
+	var chunks [][]string
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			if q.Get("function") != "REALTIME_BULK_QUOTES" {
+				return nil, fmt.Errorf("expected function REALTIME_BULK_QUOTES, got %q", q.Get("function"))
+			}
+			symbols := strings.Split(q.Get("symbol"), ",")
+			chunks = append(chunks, symbols)
+
+			// The third chunk fails outright; S007 is silently omitted.
+			if len(chunks) == 3 {
+				return &http.Response{
+					StatusCode: http.StatusBadGateway,
+					Status:     "502 Bad Gateway",
+					Body:       io.NopCloser(strings.NewReader("")),
+					Header:     make(http.Header),
+					Request:    req,
+				}, nil
+			}
+
+			var data []string
+			for _, s := range symbols {
+				if s == "S007" {
+					continue
+				}
+				data = append(data, fmt.Sprintf(`{"symbol": %q, "close": "1.5", "volume": "10", "open": "1", "high": "2", "low": "1", "previous_close": "1", "change": "0.5", "change_percent": "50%%"}`, s))
+			}
+			body := `{"endpoint": "Realtime Bulk Quotes", "data": [` + strings.Join(data, ",") + `]}`
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	symbols := make([]string, 0, 251)
+	for i := 0; i < 250; i++ {
+		symbols = append(symbols, fmt.Sprintf("s%03d", i))
+	}
+	symbols = append(symbols, "S001") // duplicate after normalization
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	result, err := cli.CoreStocks().BulkQuotes(symbols)
+	if err != nil {
+		t.Fatalf("BulkQuotes returned error: %v", err)
+	}
+
+	if len(chunks) != 3 || len(chunks[0]) != 100 || len(chunks[1]) != 100 || len(chunks[2]) != 50 {
+		t.Fatalf("unexpected chunking: %d requests", len(chunks))
+	}
+	if len(result.Quotes) != 199 {
+		t.Fatalf("expected 199 quotes, got %d", len(result.Quotes))
+	}
+	if q := result.Quotes["S001"]; q.Close != 1.5 || q.ChangePercent != 50 {
+		t.Fatalf("unexpected S001 quote: %+v", q)
+	}
+
+	if len(result.Errors) != 51 {
+		t.Fatalf("expected 51 errors, got %d", len(result.Errors))
+	}
+	if err := result.Errors["S007"]; !errors.Is(err, types.ErrSymbolNotReturned) {
+		t.Fatalf("expected ErrSymbolNotReturned for S007, got %v", err)
+	}
+	if err := result.Errors["S249"]; !errors.Is(err, types.ErrHTTPStatus) {
+		t.Fatalf("expected ErrHTTPStatus for S249, got %v", err)
+	}
+}
+
+func TestCoreStocks_BulkQuotes_RequiresSymbols(t *testing.T) {
+	cli := av.NewClient("test-key")
+	if _, err := cli.CoreStocks().BulkQuotes([]string{" ", ""}); !errors.Is(err, types.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter, got %v", err)
+	}
+}
+
+func TestCoreStocks_MarketStatus_ParsesResponse(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/market_status.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if fn := req.URL.Query().Get("function"); fn != "MARKET_STATUS" {
+				return nil, fmt.Errorf("expected function MARKET_STATUS, got %q", fn)
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	status, err := cli.CoreStocks().MarketStatus()
+	if err != nil {
+		t.Fatalf("MarketStatus returned error: %v", err)
+	}
+	if len(status.Markets) != 4 {
+		t.Fatalf("expected 4 markets, got %d", len(status.Markets))
+	}
+}
+
+func TestCoreStocks_Intraday_SendsAdjustedExtendedHoursAndEntitlement(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			want := map[string]string{
+				"function":       "TIME_SERIES_INTRADAY",
+				"interval":       "5min",
+				"adjusted":       "false",
+				"extended_hours": "false",
+				"entitlement":    "delayed",
+			}
+			for key, value := range want {
+				if q.Get(key) != value {
+					return nil, fmt.Errorf("expected %s=%q, got %q", key, value, q.Get(key))
+				}
+			}
+
+			body := `{"Meta Data": {"1. Information": "Intraday (5min) open, high, low, close prices and volume", "2. Symbol": "IBM", "3. Last Refreshed": "2024-07-10 16:00:00", "4. Interval": "5min", "5. Output Size": "Compact", "6. Time Zone": "US/Eastern"},
+				"Time Series (5min)": {"2024-07-10 16:00:00": {"1. open": "176.90", "2. high": "177.00", "3. low": "176.80", "4. close": "176.95", "5. volume": "12345"}}}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.CoreStocks().Intraday(types.TimeSeriesParams{
+		Symbol:        "IBM",
+		Interval:      "5min",
+		Adjusted:      types.Bool(false),
+		ExtendedHours: types.Bool(false),
+		Entitlement:   types.EntitlementDelayed,
+	})
+	if err != nil {
+		t.Fatalf("Intraday returned error: %v", err)
+	}
+	if len(resp.TimeSeries) != 1 {
+		t.Fatalf("expected 1 bar, got %d", len(resp.TimeSeries))
+	}
+}
+
+func TestCoreStocks_ValidatesIntradayOnlyAndEntitlementParameters(t *testing.T) {
+	cli := av.NewClientWithHTTPClient("test-key", &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("unexpected request to %s", req.URL)
+		}),
+	})
+
+	_, err := cli.CoreStocks().Daily(types.TimeSeriesParams{Symbol: "IBM", Adjusted: types.Bool(false)})
+	if !errors.Is(err, types.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter for adjusted on daily, got %v", err)
+	}
+
+	_, err = cli.CoreStocks().Intraday(types.TimeSeriesParams{Symbol: "IBM", Interval: "5min", Entitlement: "live"})
+	if !errors.Is(err, types.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter for entitlement, got %v", err)
+	}
+
+	_, err = cli.CoreStocks().QuoteWithParams(types.QuoteParams{Symbol: "IBM", Entitlement: "live"})
+	if !errors.Is(err, types.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter for quote entitlement, got %v", err)
+	}
+}
+
+func TestCoreStocks_QuoteWithParams_ParsesDelayedResponse(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if e := req.URL.Query().Get("entitlement"); e != "delayed" {
+				return nil, fmt.Errorf("expected entitlement delayed, got %q", e)
+			}
+
+			body := `{"Global Quote - DATA DELAYED BY 15 MINUTES": {"01. symbol": "IBM", "05. price": "176.95", "06. volume": "12345", "07. latest trading day": "2024-07-10"}}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	quote, err := cli.CoreStocks().QuoteWithParams(types.QuoteParams{Symbol: "IBM", Entitlement: types.EntitlementDelayed})
+	if err != nil {
+		t.Fatalf("QuoteWithParams returned error: %v", err)
+	}
+	if quote.Symbol != "IBM" || quote.Price != 176.95 {
+		t.Fatalf("unexpected quote: %+v", quote)
+	}
+}
+
+func TestCoreStocks_Intraday_SendsTypedMonthAndOutputSize(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			want := map[string]string{
+				"function":   "TIME_SERIES_INTRADAY",
+				"interval":   "15min",
+				"month":      "2009-01",
+				"outputsize": "full",
+			}
+			for key, value := range want {
+				if q.Get(key) != value {
+					return nil, fmt.Errorf("expected %s=%q, got %q", key, value, q.Get(key))
+				}
+			}
+
+			body := `{"Meta Data": {"1. Information": "Intraday (15min) open, high, low, close prices and volume", "2. Symbol": "IBM", "3. Last Refreshed": "2009-01-30 16:00:00", "4. Interval": "15min", "5. Output Size": "Full size", "6. Time Zone": "US/Eastern"},
+				"Time Series (15min)": {"2009-01-30 16:00:00": {"1. open": "92.10", "2. high": "92.20", "3. low": "91.90", "4. close": "92.00", "5. volume": "54321"}}}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	_, err := cli.CoreStocks().Intraday(types.TimeSeriesParams{
+		Symbol:     "IBM",
+		Interval:   types.Interval15Min,
+		Month:      types.NewYearMonth(2009, time.January),
+		OutputSize: types.OutputSizeFull,
+	})
+	if err != nil {
+		t.Fatalf("Intraday returned error: %v", err)
+	}
+}
+
+func TestTypedParameters_RejectInvalidValues(t *testing.T) {
+	cli := av.NewClientWithHTTPClient("test-key", &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("unexpected request to %s", req.URL)
+		}),
+	})
+
+	calls := map[string]func() error{
+		"intraday daily interval": func() error {
+			_, err := cli.CoreStocks().Intraday(types.TimeSeriesParams{Symbol: "IBM", Interval: types.IntervalDaily})
+			return err
+		},
+		"daily month": func() error {
+			_, err := cli.CoreStocks().Daily(types.TimeSeriesParams{Symbol: "IBM", Month: types.NewYearMonth(2020, time.March)})
+			return err
+		},
+		"daily outputsize": func() error {
+			_, err := cli.CoreStocks().Daily(types.TimeSeriesParams{Symbol: "IBM", OutputSize: "everything"})
+			return err
+		},
+		"indicator month on daily": func() error {
+			_, err := cli.TechnicalIndicators().SMA(types.IndicatorParams{Symbol: "IBM", Interval: types.IntervalDaily, Month: types.NewYearMonth(2020, time.March)})
+			return err
+		},
+		"crypto interval": func() error {
+			_, err := cli.Crypto().Intraday(types.CryptoIntradayParams{Symbol: "ETH", Market: "USD", Interval: "2min"})
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, types.ErrInvalidParameter) {
+			t.Fatalf("%s: expected ErrInvalidParameter, got %v", name, err)
+		}
+	}
+}
+
+func TestTechnicalIndicators_MACD_SendsOnlyAcceptedParameters(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			want := map[string]string{
+				"function":     "MACD",
+				"interval":     "daily",
+				"series_type":  "close",
+				"fastperiod":   "10",
+				"slowperiod":   "30",
+				"signalperiod": "7",
+			}
+			for key, value := range want {
+				if q.Get(key) != value {
+					return nil, fmt.Errorf("expected %s=%q, got %q", key, value, q.Get(key))
+				}
+			}
+			if q.Has("time_period") {
+				return nil, fmt.Errorf("MACD does not accept time_period, got %q", q.Get("time_period"))
+			}
+			if q.Has("outputsize") {
+				return nil, fmt.Errorf("MACD does not accept outputsize, got %q", q.Get("outputsize"))
+			}
+
+			body := `{"Meta Data": {"1: Symbol": "IBM", "2: Indicator": "Moving Average Convergence/Divergence (MACD)", "3: Last Refreshed": "2024-07-10", "4: Interval": "daily"},
+				"Technical Analysis: MACD": {"2024-07-10": {"MACD": "1.2", "MACD_Signal": "0.9", "MACD_Hist": "0.3"}}}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.TechnicalIndicators().MACD(types.MACDParams{
+		IndicatorParams: types.IndicatorParams{
+			Symbol:     "IBM",
+			Interval:   types.IntervalDaily,
+			TimePeriod: 20,
+			SeriesType: "close",
+		},
+		FastPeriod:   10,
+		SlowPeriod:   30,
+		SignalPeriod: 7,
+	})
+	if err != nil {
+		t.Fatalf("MACD returned error: %v", err)
+	}
+	if len(resp.IndicatorValues) != 1 || resp.IndicatorValues[0].Values["MACD_Hist"] != 0.3 {
+		t.Fatalf("unexpected MACD values: %+v", resp.IndicatorValues)
+	}
+}
+
+func TestTechnicalIndicators_ValidatesPerIndicatorParameters(t *testing.T) {
+	cli := av.NewClientWithHTTPClient("test-key", &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("unexpected request to %s", req.URL)
+		}),
+	})
+	base := types.IndicatorParams{Symbol: "IBM", Interval: types.IntervalDaily, TimePeriod: 20, SeriesType: "close"}
+
+	calls := map[string]func() error{
+		"rsi missing time period": func() error {
+			_, err := cli.TechnicalIndicators().RSI(types.IndicatorParams{Symbol: "IBM", Interval: types.IntervalDaily, SeriesType: "close"})
+			return err
+		},
+		"sma bad series type": func() error {
+			_, err := cli.TechnicalIndicators().SMA(types.IndicatorParams{Symbol: "IBM", Interval: types.IntervalDaily, TimePeriod: 20, SeriesType: "median"})
+			return err
+		},
+		"bbands matype": func() error {
+			_, err := cli.TechnicalIndicators().BBANDS(types.BBANDSParams{IndicatorParams: base, MAType: 9})
+			return err
+		},
+		"sar negative acceleration": func() error {
+			_, err := cli.TechnicalIndicators().SAR(types.SARParams{IndicatorParams: base, Acceleration: -0.01})
+			return err
+		},
+		"vwap daily interval": func() error {
+			_, err := cli.TechnicalIndicators().VWAP(base)
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, types.ErrInvalidParameter) {
+			t.Fatalf("%s: expected ErrInvalidParameter, got %v", name, err)
+		}
+	}
+}
+
+func TestTechnicalIndicators_SMA_ParsesDateOnlyDailyKeys(t *testing.T) {
+	fixture := []byte(`{
+  "Meta Data": {
+    "1: Symbol": "IBM",
+    "2: Indicator": "Simple Moving Average (SMA)",
+    "3: Last Refreshed": "2025-12-12",
+    "4: Interval": "daily",
+    "5: Time Period": 20,
+    "6: Series Type": "close",
+    "7: Time Zone": "US/Eastern"
+  },
+  "Technical Analysis: SMA": {
+    "2025-12-12": {
+      "SMA": "123.4500"
+    },
+    "2025-12-11": {
+      "SMA": "122.9000"
+    }
+  }
+}`)
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(fixture)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	cli := av.NewClientWithHTTPClient("test-key", httpClient)
+	resp, err := cli.TechnicalIndicators().SMA(types.IndicatorParams{
+		Symbol:     "IBM",
+		Interval:   types.IntervalDaily,
+		TimePeriod: 20,
+		SeriesType: "close",
+	})
+	if err != nil {
+		t.Fatalf("SMA returned error: %v", err)
+	}
+	if len(resp.IndicatorValues) != 2 {
+		t.Fatalf("expected 2 indicator values, got %d", len(resp.IndicatorValues))
+	}
+
+	want := time.Date(2025, 12, 11, 0, 0, 0, 0, time.UTC)
+	if first := resp.IndicatorValues[0]; !first.Timestamp.Equal(want) || first.Values["SMA"] != 122.9 {
+		t.Fatalf("unexpected first value: %+v", first)
+	}
+}
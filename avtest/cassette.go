@@ -0,0 +1,102 @@
+// Package avtest provides test helpers for exercising the SDK without network
+// access: a recording http.RoundTripper that captures real Alpha Vantage
+// responses into cassette files, and a replaying transport that serves them back.
+package avtest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Interaction is a single recorded request/response pair. Params never include
+// the apikey parameter.
+type Interaction struct {
+	Function    string              `json:"function"`
+	Params      map[string][]string `json:"params"`
+	StatusCode  int                 `json:"status_code"`
+	ContentType string              `json:"content_type,omitempty"`
+	Body        string              `json:"body"`
+}
+
+// RequestKey returns the normalized key used to match requests to
+// interactions: the query string without apikey, with keys sorted.
+func RequestKey(query url.Values) string {
+	q := url.Values{}
+	for k, v := range query {
+		if strings.EqualFold(k, "apikey") {
+			continue
+		}
+		q[k] = append([]string(nil), v...)
+	}
+	return q.Encode()
+}
+
+// key returns the RequestKey for the interaction's params.
+func (i Interaction) key() string {
+	return RequestKey(url.Values(i.Params))
+}
+
+// fileName returns a stable, readable cassette file name for the interaction.
+func (i Interaction) fileName() string {
+	sum := sha256.Sum256([]byte(i.key()))
+	function := i.Function
+	if function == "" {
+		function = "UNKNOWN"
+	}
+	return fmt.Sprintf("%s-%s.json", function, hex.EncodeToString(sum[:6]))
+}
+
+// response builds an *http.Response for req from the interaction.
+func (i Interaction) response(req *http.Request) *http.Response {
+	header := make(http.Header)
+	if i.ContentType != "" {
+		header.Set("Content-Type", i.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.StatusCode, http.StatusText(i.StatusCode)),
+		StatusCode:    i.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          newBody(i.Body),
+		ContentLength: int64(len(i.Body)),
+		Request:       req,
+	}
+}
+
+func writeInteraction(dir string, i Interaction) error {
+	data, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, i.fileName()), append(data, '\n'), 0o644)
+}
+
+func readInteractions(dir string) ([]Interaction, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	interactions := make([]Interaction, 0, len(paths))
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		var i Interaction
+		if err := json.Unmarshal(data, &i); err != nil {
+			return nil, fmt.Errorf("avtest: parse cassette %s: %w", p, err)
+		}
+		interactions = append(interactions, i)
+	}
+	return interactions, nil
+}
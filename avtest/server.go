@@ -0,0 +1,205 @@
+package avtest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+// Canned Alpha Vantage message bodies used by the Response helpers.
+const (
+	ThrottleNote       = "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 25 calls per day."
+	PremiumInformation = "Thank you for using Alpha Vantage! This is a premium endpoint. You may subscribe to any of the premium plans at https://www.alphavantage.co/premium/ to instantly unlock all premium endpoints"
+	InvalidCallMessage = "Invalid API call. Please retry or visit the documentation (https://www.alphavantage.co/documentation/)."
+)
+
+// Response is a scripted reply from Server.
+type Response struct {
+	StatusCode  int
+	ContentType string
+	Body        string
+	// Delay holds the response back; it is cut short if the client gives up.
+	Delay time.Duration
+}
+
+// JSON returns a 200 response with a JSON body.
+func JSON(body string) Response {
+	return Response{StatusCode: http.StatusOK, ContentType: "application/json", Body: body}
+}
+
+// CSV returns a 200 response with a CSV body.
+func CSV(body string) Response {
+	return Response{StatusCode: http.StatusOK, ContentType: "application/x-download", Body: body}
+}
+
+// MustFixture returns a JSON response whose body is read from path, such as a
+// file under models/testdata. It panics if the file cannot be read.
+func MustFixture(path string) Response {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		panic("avtest: read fixture: " + err.Error())
+	}
+	return JSON(string(data))
+}
+
+// Throttle returns a rate-limit "Note" payload.
+func Throttle() Response {
+	return JSON(`{"Note": "` + ThrottleNote + `"}`)
+}
+
+// Premium returns a premium-endpoint "Information" payload.
+func Premium() Response {
+	return JSON(`{"Information": "` + PremiumInformation + `"}`)
+}
+
+// InvalidCall returns an "Error Message" payload, as sent for unknown symbols.
+func InvalidCall() Response {
+	return JSON(`{"Error Message": "` + InvalidCallMessage + `"}`)
+}
+
+// Status returns an empty response with the given HTTP status code.
+func Status(code int) Response {
+	return Response{StatusCode: code}
+}
+
+// WithDelay returns a copy of r delayed by d.
+func (r Response) WithDelay(d time.Duration) Response {
+	r.Delay = d
+	return r
+}
+
+// Server is a fake Alpha Vantage API built on httptest. Requests are dispatched
+// on the function parameter; each function serves its scripted responses in
+// order and keeps repeating the last one. Recorded interactions added via
+// AddInteraction or LoadCassettes take precedence when their params match
+// exactly. Unknown functions get an "Error Message" payload, like the real API.
+type Server struct {
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	routes   map[string][]Response
+	served   map[string]int
+	replayer Replayer
+	requests []url.Values
+}
+
+// NewServer starts a Server. Callers must Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		routes: make(map[string][]Response),
+		served: make(map[string]int),
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// URL returns the query endpoint to pass to av.WithBaseURL.
+func (s *Server) URL() string {
+	return s.srv.URL + "/query"
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Handle scripts the responses for function, replacing any previous script.
+func (s *Server) Handle(function string, responses ...Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[function] = responses
+	s.served[function] = 0
+}
+
+// AddInteraction serves i for requests whose params match it exactly.
+func (s *Server) AddInteraction(i Interaction) {
+	s.replayer.Add(i)
+}
+
+// LoadCassettes serves every interaction recorded under dir.
+func (s *Server) LoadCassettes(dir string) error {
+	interactions, err := readInteractions(dir)
+	if err != nil {
+		return err
+	}
+	for _, i := range interactions {
+		s.replayer.Add(i)
+	}
+	return nil
+}
+
+// Requests returns the query parameters of every request received so far.
+func (s *Server) Requests() []url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]url.Values(nil), s.requests...)
+}
+
+// Count returns how many requests for function have been received.
+func (s *Server) Count(function string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, q := range s.requests {
+		if q.Get("function") == function {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	resp := s.next(query)
+
+	if resp.Delay > 0 {
+		timer := time.NewTimer(resp.Delay)
+		defer timer.Stop()
+		select {
+		case <-r.Context().Done():
+			return
+		case <-timer.C:
+		}
+	}
+
+	if resp.ContentType != "" {
+		w.Header().Set("Content-Type", resp.ContentType)
+	}
+	status := resp.StatusCode
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	w.Write([]byte(resp.Body))
+}
+
+// next records the request and picks the response to serve for it.
+func (s *Server) next(query url.Values) Response {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, query)
+
+	s.replayer.mu.RLock()
+	i, ok := s.replayer.interactions[RequestKey(query)]
+	s.replayer.mu.RUnlock()
+	if ok {
+		return Response{StatusCode: i.StatusCode, ContentType: i.ContentType, Body: i.Body}
+	}
+
+	function := query.Get("function")
+	script := s.routes[function]
+	if len(script) == 0 {
+		return InvalidCall()
+	}
+
+	n := s.served[function]
+	s.served[function]++
+	if n >= len(script) {
+		n = len(script) - 1
+	}
+	return script[n]
+}
@@ -0,0 +1,126 @@
+package avtest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/av"
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/avtest"
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+func fastRetry(attempts int) av.Option {
+	return av.WithRetryPolicy(types.RetryPolicy{MaxAttempts: attempts, InitialBackoff: time.Millisecond})
+}
+
+func TestServer_ServesFixturesByFunction(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	srv.Handle("ETF_PROFILE", avtest.MustFixture("../models/testdata/etf_profile_QQQ.json"))
+	srv.Handle("SPLITS", avtest.MustFixture("../models/testdata/splits_IBM.json"))
+
+	cli := av.NewClient("test-key", av.WithBaseURL(srv.URL()))
+
+	profile, err := cli.FundamentalData().ETFProfile("QQQ")
+	if err != nil {
+		t.Fatalf("ETFProfile returned error: %v", err)
+	}
+	if profile.NetAssets == 0 {
+		t.Fatalf("expected non-zero net assets")
+	}
+
+	splits, err := cli.FundamentalData().Splits("IBM")
+	if err != nil {
+		t.Fatalf("Splits returned error: %v", err)
+	}
+	if splits.Symbol != "IBM" {
+		t.Fatalf("expected IBM, got %q", splits.Symbol)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 2 || reqs[0].Get("apikey") != "test-key" || reqs[1].Get("symbol") != "IBM" {
+		t.Fatalf("unexpected recorded requests: %v", reqs)
+	}
+}
+
+func TestServer_RetriesThrottleThenSucceeds(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	srv.Handle("DIVIDENDS", avtest.Throttle(), avtest.Status(http.StatusBadGateway), avtest.MustFixture("../models/testdata/dividends_IBM.json"))
+
+	cli := av.NewClient("test-key", av.WithBaseURL(srv.URL()), fastRetry(3))
+	if _, err := cli.FundamentalData().Dividends("IBM"); err != nil {
+		t.Fatalf("Dividends returned error: %v", err)
+	}
+	if n := srv.Count("DIVIDENDS"); n != 3 {
+		t.Fatalf("expected 3 attempts, got %d", n)
+	}
+}
+
+func TestServer_ErrorPayloadsMapToTypedErrors(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	srv.Handle("ETF_PROFILE", avtest.Premium())
+	srv.Handle("OVERVIEW", avtest.Status(http.StatusInternalServerError))
+
+	cli := av.NewClient("test-key", av.WithBaseURL(srv.URL()), fastRetry(3))
+
+	if _, err := cli.FundamentalData().ETFProfile("QQQ"); !errors.Is(err, types.ErrPremiumRequired) {
+		t.Fatalf("expected ErrPremiumRequired, got %v", err)
+	}
+	if _, err := cli.CoreStocks().Quote("NOPE"); !errors.Is(err, types.ErrInvalidAPICall) {
+		t.Fatalf("expected ErrInvalidAPICall for unscripted function, got %v", err)
+	}
+
+	_, err := cli.FundamentalData().CompanyOverview("IBM")
+	var statusErr *types.HTTPStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected 500 HTTPStatusError, got %v", err)
+	}
+	if n := srv.Count("ETF_PROFILE"); n != 1 {
+		t.Fatalf("expected premium notice not to be retried, got %d attempts", n)
+	}
+	if n := srv.Count("OVERVIEW"); n != 3 {
+		t.Fatalf("expected 500s to be retried up to 3 attempts, got %d", n)
+	}
+}
+
+func TestServer_SlowResponsesHitTimeouts(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	srv.Handle("GLOBAL_QUOTE", avtest.JSON(`{"Global Quote": {"01. symbol": "IBM"}}`).WithDelay(time.Second))
+
+	cli := av.NewClient("test-key", av.WithBaseURL(srv.URL()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if _, err := cli.CoreStocks().QuoteContext(ctx, "IBM"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestServer_RateLimiterPreventsThrottle(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	srv.Handle("GLOBAL_QUOTE", avtest.JSON(`{"Global Quote": {"01. symbol": "IBM"}}`))
+
+	cli := av.NewClient("test-key",
+		av.WithBaseURL(srv.URL()),
+		av.WithRateLimit(types.RateLimit{RequestsPerMinute: 2, Policy: types.RateLimitFailFast}),
+	)
+
+	for i := 0; i < 2; i++ {
+		if _, err := cli.CoreStocks().Quote("IBM"); err != nil {
+			t.Fatalf("Quote %d returned error: %v", i+1, err)
+		}
+	}
+	if _, err := cli.CoreStocks().Quote("IBM"); !errors.Is(err, types.ErrRateLimitExceeded) {
+		t.Fatalf("expected ErrRateLimitExceeded, got %v", err)
+	}
+	if n := srv.Count("GLOBAL_QUOTE"); n != 2 {
+		t.Fatalf("expected 2 requests to reach the server, got %d", n)
+	}
+}
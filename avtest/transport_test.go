@@ -0,0 +1,84 @@
+package avtest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/av"
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/avtest"
+)
+
+func TestRecordThenReplay_ServesResponsesWithoutNetwork(t *testing.T) {
+	fixture, err := os.ReadFile("../models/testdata/dividends_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer upstream.Close()
+
+	dir := t.TempDir()
+	recorder, err := avtest.NewRecorder(dir, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+
+	recording := av.NewClient("real-secret-key", av.WithBaseURL(upstream.URL), av.WithHTTPClient(recorder.Client()))
+	if _, err := recording.FundamentalData().Dividends("IBM"); err != nil {
+		t.Fatalf("recording Dividends returned error: %v", err)
+	}
+	upstream.Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, "DIVIDENDS-*.json"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected 1 DIVIDENDS cassette, got %v (%v)", files, err)
+	}
+	cassette, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatalf("failed to read cassette: %v", err)
+	}
+	if strings.Contains(string(cassette), "real-secret-key") || strings.Contains(string(cassette), "apikey") {
+		t.Fatalf("cassette leaks API key:\n%s", cassette)
+	}
+
+	replayer, err := avtest.NewReplayer(dir)
+	if err != nil {
+		t.Fatalf("NewReplayer returned error: %v", err)
+	}
+
+	replaying := av.NewClient("different-key", av.WithHTTPClient(replayer.Client()))
+	resp, err := replaying.FundamentalData().Dividends("IBM")
+	if err != nil {
+		t.Fatalf("replayed Dividends returned error: %v", err)
+	}
+	if resp.Symbol != "IBM" || len(resp.Data) == 0 {
+		t.Fatalf("unexpected replayed response: %+v", resp)
+	}
+}
+
+func TestReplayer_UnknownRequestFails(t *testing.T) {
+	replayer := &avtest.Replayer{}
+	replayer.Add(avtest.Interaction{
+		Function:   "GLOBAL_QUOTE",
+		Params:     map[string][]string{"function": {"GLOBAL_QUOTE"}, "symbol": {"IBM"}, "datatype": {"json"}},
+		StatusCode: http.StatusOK,
+		Body:       `{"Global Quote": {"01. symbol": "IBM"}}`,
+	})
+
+	cli := av.NewClient("test-key", av.WithHTTPClient(replayer.Client()))
+	if _, err := cli.CoreStocks().Quote("IBM"); err != nil {
+		t.Fatalf("Quote returned error: %v", err)
+	}
+
+	_, err := cli.CoreStocks().Quote("MSFT")
+	if err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+		t.Fatalf("expected missing interaction error, got %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+// Package indicators computes technical indicators locally from time series
+// bars, saving the API request a TechnicalIndicators call would cost. The
+// calculations follow the TA-Lib conventions Alpha Vantage uses, and results
+// are returned as *types.IndicatorResponse with the same value names as the
+// API, oldest first.
+package indicators
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// Series types accepted by the price based indicators.
+const (
+	SeriesClose = "close"
+	SeriesOpen  = "open"
+	SeriesHigh  = "high"
+	SeriesLow   = "low"
+)
+
+// Adjusted converts adjusted bars to OHLCV, scaling open, high, low and close
+// by AdjustedClose/Close so indicators see split and dividend adjusted prices,
+// as the API does for daily, weekly and monthly indicators. Bars without a
+// close are copied unchanged.
+func Adjusted(bars []types.AdjustedOHLCV) []types.OHLCV {
+	out := make([]types.OHLCV, len(bars))
+	for i, bar := range bars {
+		out[i] = bar.OHLCV
+		if bar.Close == 0 || bar.AdjustedClose == 0 {
+			continue
+		}
+		factor := bar.AdjustedClose / bar.Close
+		out[i].Open *= factor
+		out[i].High *= factor
+		out[i].Low *= factor
+		out[i].Close = bar.AdjustedClose
+	}
+	return out
+}
+
+// sorted returns a copy of bars ordered oldest first.
+func sorted(bars []types.OHLCV) []types.OHLCV {
+	out := append([]types.OHLCV(nil), bars...)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Timestamp.Before(out[j].Timestamp)
+	})
+	return out
+}
+
+// prices extracts the seriesType price of each bar.
+func prices(function string, bars []types.OHLCV, seriesType string) ([]float64, error) {
+	var pick func(types.OHLCV) float64
+	switch strings.ToLower(strings.TrimSpace(seriesType)) {
+	case SeriesClose:
+		pick = func(b types.OHLCV) float64 { return b.Close }
+	case SeriesOpen:
+		pick = func(b types.OHLCV) float64 { return b.Open }
+	case SeriesHigh:
+		pick = func(b types.OHLCV) float64 { return b.High }
+	case SeriesLow:
+		pick = func(b types.OHLCV) float64 { return b.Low }
+	default:
+		return nil, types.NewParameterError(function, "series_type", "series_type must be one of close, open, high, low")
+	}
+
+	out := make([]float64, len(bars))
+	for i, bar := range bars {
+		out[i] = pick(bar)
+	}
+	return out, nil
+}
+
+// checkPeriod verifies that a period parameter is positive.
+func checkPeriod(function, name string, period int) error {
+	if period <= 0 {
+		return types.NewParameterError(function, name, name+" must be positive")
+	}
+	return nil
+}
+
+// checkPeriods checks each period against the parameter name at the same index.
+func checkPeriods(function string, names []string, periods ...int) error {
+	for i, period := range periods {
+		if err := checkPeriod(function, names[i], period); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newResponse returns an empty response carrying the indicator description.
+func newResponse(information string, timePeriod int, seriesType string) *types.IndicatorResponse {
+	return &types.IndicatorResponse{
+		MetaData: types.TimeSeriesMetaData{
+			Information: information,
+			TimePeriod:  float64(timePeriod),
+			SeriesType:  strings.ToLower(strings.TrimSpace(seriesType)),
+		},
+	}
+}
+
+// addValues appends one value per bar from start onwards.
+func addValues(resp *types.IndicatorResponse, bars []types.OHLCV, start int, values func(i int) map[string]float64) {
+	if start < 0 {
+		start = 0
+	}
+	for i := start; i < len(bars); i++ {
+		resp.IndicatorValues = append(resp.IndicatorValues, types.IndicatorValue{
+			Timestamp: bars[i].Timestamp,
+			Values:    values(i),
+		})
+	}
+}
+
+// trueRange returns the true range of bar i, which needs the previous close.
+func trueRange(bars []types.OHLCV, i int) float64 {
+	prevClose := bars[i-1].Close
+	tr := bars[i].High - bars[i].Low
+	if v := abs(bars[i].High - prevClose); v > tr {
+		tr = v
+	}
+	if v := abs(bars[i].Low - prevClose); v > tr {
+		tr = v
+	}
+	return tr
+}
+
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
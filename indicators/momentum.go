@@ -0,0 +1,210 @@
+package indicators
+
+import "github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+
+// RSI computes Wilder's relative strength index of seriesType over period bars.
+// The first value needs period price changes, so it falls on bar period.
+func RSI(bars []types.OHLCV, period int, seriesType string) (*types.IndicatorResponse, error) {
+	if err := checkPeriod("RSI", "time_period", period); err != nil {
+		return nil, err
+	}
+	bars = sorted(bars)
+	x, err := prices("RSI", bars, seriesType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := newResponse("Relative Strength Index (RSI)", period, seriesType)
+	if len(x) <= period {
+		return resp, nil
+	}
+
+	n := float64(period)
+	var gain, loss float64
+	for i := 1; i <= period; i++ {
+		if d := x[i] - x[i-1]; d > 0 {
+			gain += d
+		} else {
+			loss -= d
+		}
+	}
+	gain /= n
+	loss /= n
+
+	rsi := make([]float64, len(x))
+	rsi[period] = relativeStrength(gain, loss)
+	for i := period + 1; i < len(x); i++ {
+		var g, l float64
+		if d := x[i] - x[i-1]; d > 0 {
+			g = d
+		} else {
+			l = -d
+		}
+		gain = (gain*(n-1) + g) / n
+		loss = (loss*(n-1) + l) / n
+		rsi[i] = relativeStrength(gain, loss)
+	}
+
+	addValues(resp, bars, period, func(i int) map[string]float64 {
+		return map[string]float64{"RSI": rsi[i]}
+	})
+	return resp, nil
+}
+
+func relativeStrength(gain, loss float64) float64 {
+	if gain+loss == 0 {
+		return 0
+	}
+	return 100 * gain / (gain + loss)
+}
+
+// MACD computes the moving average convergence/divergence of seriesType with
+// the given fast, slow and signal periods (12, 26 and 9 by convention).
+func MACD(bars []types.OHLCV, fastPeriod, slowPeriod, signalPeriod int, seriesType string) (*types.IndicatorResponse, error) {
+	if err := checkPeriods("MACD", []string{"fastperiod", "slowperiod", "signalperiod"}, fastPeriod, slowPeriod, signalPeriod); err != nil {
+		return nil, err
+	}
+	if fastPeriod >= slowPeriod {
+		return nil, types.NewParameterError("MACD", "fastperiod", "fastperiod must be less than slowperiod")
+	}
+	bars = sorted(bars)
+	x, err := prices("MACD", bars, seriesType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := newResponse("Moving Average Convergence/Divergence (MACD)", 0, seriesType)
+	start := slowPeriod + signalPeriod - 2
+	if len(x) <= start {
+		return resp, nil
+	}
+
+	// Both averages are seeded on the bar the slow average first exists.
+	fast := ema(x, fastPeriod, slowPeriod-1)
+	slow := ema(x, slowPeriod, slowPeriod-1)
+	line := make([]float64, len(x))
+	for i := slowPeriod - 1; i < len(x); i++ {
+		line[i] = fast[i] - slow[i]
+	}
+	signal := ema(line, signalPeriod, start)
+
+	addValues(resp, bars, start, func(i int) map[string]float64 {
+		return map[string]float64{
+			"MACD":        line[i],
+			"MACD_Signal": signal[i],
+			"MACD_Hist":   line[i] - signal[i],
+		}
+	})
+	return resp, nil
+}
+
+// STOCH computes the slow stochastic oscillator using simple moving averages
+// for the K and D lines (5, 3 and 3 by convention).
+func STOCH(bars []types.OHLCV, fastKPeriod, slowKPeriod, slowDPeriod int) (*types.IndicatorResponse, error) {
+	if err := checkPeriods("STOCH", []string{"fastkperiod", "slowkperiod", "slowdperiod"}, fastKPeriod, slowKPeriod, slowDPeriod); err != nil {
+		return nil, err
+	}
+	bars = sorted(bars)
+
+	resp := newResponse("Stochastic (STOCH)", 0, "")
+	start := fastKPeriod + slowKPeriod + slowDPeriod - 3
+	if len(bars) <= start {
+		return resp, nil
+	}
+
+	fastK := make([]float64, len(bars))
+	for i := fastKPeriod - 1; i < len(bars); i++ {
+		highest, lowest := bars[i].High, bars[i].Low
+		for _, bar := range bars[i-fastKPeriod+1 : i] {
+			highest = max(highest, bar.High)
+			lowest = min(lowest, bar.Low)
+		}
+		if highest != lowest {
+			fastK[i] = 100 * (bars[i].Close - lowest) / (highest - lowest)
+		}
+	}
+
+	slowK := sma(fastK[fastKPeriod-1:], slowKPeriod)
+	slowD := sma(slowK[slowKPeriod-1:], slowDPeriod)
+	addValues(resp, bars, start, func(i int) map[string]float64 {
+		return map[string]float64{
+			"SlowK": slowK[i-fastKPeriod+1],
+			"SlowD": slowD[i-fastKPeriod-slowKPeriod+2],
+		}
+	})
+	return resp, nil
+}
+
+// ADX computes Wilder's average directional movement index over period bars.
+// The first value falls on bar 2*period-1.
+func ADX(bars []types.OHLCV, period int) (*types.IndicatorResponse, error) {
+	if err := checkPeriod("ADX", "time_period", period); err != nil {
+		return nil, err
+	}
+	bars = sorted(bars)
+
+	resp := newResponse("Average Directional Movement Index (ADX)", period, "")
+	start := 2*period - 1
+	if len(bars) <= start {
+		return resp, nil
+	}
+
+	n := float64(period)
+	var plusDM, minusDM, tr float64
+	for i := 1; i < period; i++ {
+		p, m := directionalMovement(bars, i)
+		plusDM += p
+		minusDM += m
+		tr += trueRange(bars, i)
+	}
+
+	// smooth advances the Wilder sums to bar i and returns its DX, if defined.
+	smooth := func(i int) (float64, bool) {
+		p, m := directionalMovement(bars, i)
+		plusDM += p - plusDM/n
+		minusDM += m - minusDM/n
+		tr += trueRange(bars, i) - tr/n
+		if tr == 0 {
+			return 0, false
+		}
+		plusDI, minusDI := 100*plusDM/tr, 100*minusDM/tr
+		if plusDI+minusDI == 0 {
+			return 0, false
+		}
+		return 100 * abs(plusDI-minusDI) / (plusDI + minusDI), true
+	}
+
+	sumDX := 0.0
+	for i := period; i <= start; i++ {
+		if dx, ok := smooth(i); ok {
+			sumDX += dx
+		}
+	}
+
+	adx := make([]float64, len(bars))
+	adx[start] = sumDX / n
+	for i := start + 1; i < len(bars); i++ {
+		adx[i] = adx[i-1]
+		if dx, ok := smooth(i); ok {
+			adx[i] = (adx[i-1]*(n-1) + dx) / n
+		}
+	}
+
+	addValues(resp, bars, start, func(i int) map[string]float64 {
+		return map[string]float64{"ADX": adx[i]}
+	})
+	return resp, nil
+}
+
+// directionalMovement returns the +DM and -DM of bar i. At most one is non-zero.
+func directionalMovement(bars []types.OHLCV, i int) (plus, minus float64) {
+	up := bars[i].High - bars[i-1].High
+	down := bars[i-1].Low - bars[i].Low
+	switch {
+	case down > 0 && up < down:
+		return 0, down
+	case up > 0 && up > down:
+		return up, 0
+	}
+	return 0, 0
+}
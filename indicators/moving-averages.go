@@ -0,0 +1,98 @@
+package indicators
+
+import "github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+
+// SMA computes the simple moving average of seriesType over period bars.
+func SMA(bars []types.OHLCV, period int, seriesType string) (*types.IndicatorResponse, error) {
+	return movingAverage("SMA", "Simple Moving Average (SMA)", bars, period, seriesType, sma)
+}
+
+// EMA computes the exponential moving average of seriesType over period bars,
+// seeded with the simple average of the first period values.
+func EMA(bars []types.OHLCV, period int, seriesType string) (*types.IndicatorResponse, error) {
+	return movingAverage("EMA", "Exponential Moving Average (EMA)", bars, period, seriesType, func(x []float64, n int) []float64 {
+		return ema(x, n, n-1)
+	})
+}
+
+// WMA computes the linearly weighted moving average of seriesType over period bars.
+func WMA(bars []types.OHLCV, period int, seriesType string) (*types.IndicatorResponse, error) {
+	return movingAverage("WMA", "Weighted Moving Average (WMA)", bars, period, seriesType, wma)
+}
+
+func movingAverage(function, information string, bars []types.OHLCV, period int, seriesType string, average func([]float64, int) []float64) (*types.IndicatorResponse, error) {
+	if err := checkPeriod(function, "time_period", period); err != nil {
+		return nil, err
+	}
+	bars = sorted(bars)
+	x, err := prices(function, bars, seriesType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := newResponse(information, period, seriesType)
+	if len(x) < period {
+		return resp, nil
+	}
+	values := average(x, period)
+	addValues(resp, bars, period-1, func(i int) map[string]float64 {
+		return map[string]float64{function: values[i]}
+	})
+	return resp, nil
+}
+
+// sma returns the simple moving average of x; entries before n-1 are zero.
+func sma(x []float64, n int) []float64 {
+	out := make([]float64, len(x))
+	sum := 0.0
+	for i, v := range x {
+		sum += v
+		if i >= n {
+			sum -= x[i-n]
+		}
+		if i >= n-1 {
+			out[i] = sum / float64(n)
+		}
+	}
+	return out
+}
+
+// ema returns the exponential moving average of x seeded with the simple
+// average of the n values ending at seed. Entries before seed are zero.
+// TA-Lib seeds the fast MACD average at the slow average's seed, which is why
+// seed is a parameter.
+func ema(x []float64, n, seed int) []float64 {
+	out := make([]float64, len(x))
+	if seed >= len(x) || seed < n-1 {
+		return out
+	}
+
+	prev := 0.0
+	for _, v := range x[seed-n+1 : seed+1] {
+		prev += v
+	}
+	prev /= float64(n)
+	out[seed] = prev
+
+	k := 2 / float64(n+1)
+	for i := seed + 1; i < len(x); i++ {
+		prev += (x[i] - prev) * k
+		out[i] = prev
+	}
+	return out
+}
+
+// wma returns the weighted moving average of x, weighting the newest value n
+// and the oldest 1. Entries before n-1 are zero.
+func wma(x []float64, n int) []float64 {
+	out := make([]float64, len(x))
+	weights := float64(n*(n+1)) / 2
+	for i := n - 1; i < len(x); i++ {
+		sum := 0.0
+		for j := 0; j < n; j++ {
+			sum += x[i-n+1+j] * float64(j+1)
+		}
+		out[i] = sum / weights
+	}
+	return out
+}
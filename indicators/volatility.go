@@ -0,0 +1,72 @@
+package indicators
+
+import (
+	"math"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// BBANDS computes Bollinger Bands around the simple moving average of
+// seriesType, nbDevUp and nbDevDn population standard deviations away.
+func BBANDS(bars []types.OHLCV, period int, nbDevUp, nbDevDn float64, seriesType string) (*types.IndicatorResponse, error) {
+	if err := checkPeriod("BBANDS", "time_period", period); err != nil {
+		return nil, err
+	}
+	if nbDevUp < 0 || nbDevDn < 0 {
+		return nil, types.NewParameterError("BBANDS", "nbdevup", "band multipliers must not be negative")
+	}
+	bars = sorted(bars)
+	x, err := prices("BBANDS", bars, seriesType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := newResponse("Bollinger Bands (BBANDS)", period, seriesType)
+	if len(x) < period {
+		return resp, nil
+	}
+
+	middle := sma(x, period)
+	addValues(resp, bars, period-1, func(i int) map[string]float64 {
+		variance := 0.0
+		for _, v := range x[i-period+1 : i+1] {
+			variance += (v - middle[i]) * (v - middle[i])
+		}
+		sd := math.Sqrt(variance / float64(period))
+		return map[string]float64{
+			"Real Upper Band":  middle[i] + nbDevUp*sd,
+			"Real Middle Band": middle[i],
+			"Real Lower Band":  middle[i] - nbDevDn*sd,
+		}
+	})
+	return resp, nil
+}
+
+// ATR computes Wilder's average true range over period bars. The first value
+// needs period true ranges, so it falls on bar period.
+func ATR(bars []types.OHLCV, period int) (*types.IndicatorResponse, error) {
+	if err := checkPeriod("ATR", "time_period", period); err != nil {
+		return nil, err
+	}
+	bars = sorted(bars)
+
+	resp := newResponse("Average True Range (ATR)", period, "")
+	if len(bars) <= period {
+		return resp, nil
+	}
+
+	n := float64(period)
+	atr := make([]float64, len(bars))
+	for i := 1; i <= period; i++ {
+		atr[period] += trueRange(bars, i)
+	}
+	atr[period] /= n
+	for i := period + 1; i < len(bars); i++ {
+		atr[i] = (atr[i-1]*(n-1) + trueRange(bars, i)) / n
+	}
+
+	addValues(resp, bars, period, func(i int) map[string]float64 {
+		return map[string]float64{"ATR": atr[i]}
+	})
+	return resp, nil
+}
@@ -0,0 +1,55 @@
+package indicators
+
+import "github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+
+// OBV computes on balance volume, starting from the first bar's volume.
+func OBV(bars []types.OHLCV) (*types.IndicatorResponse, error) {
+	bars = sorted(bars)
+
+	resp := newResponse("On Balance Volume (OBV)", 0, "")
+	obv := 0.0
+	addValues(resp, bars, 0, func(i int) map[string]float64 {
+		switch {
+		case i == 0:
+			obv = float64(bars[i].Volume)
+		case bars[i].Close > bars[i-1].Close:
+			obv += float64(bars[i].Volume)
+		case bars[i].Close < bars[i-1].Close:
+			obv -= float64(bars[i].Volume)
+		}
+		return map[string]float64{"OBV": obv}
+	})
+	return resp, nil
+}
+
+// VWAP computes the volume weighted average typical price of intraday bars,
+// restarting each calendar day in the bars' location. Bars before any volume
+// has traded that day are skipped.
+func VWAP(bars []types.OHLCV) (*types.IndicatorResponse, error) {
+	bars = sorted(bars)
+
+	resp := newResponse("Volume Weighted Average Price (VWAP)", 0, "")
+	var priceVolume, volume float64
+	for i, bar := range bars {
+		if i == 0 || !sameDay(bar, bars[i-1]) {
+			priceVolume, volume = 0, 0
+		}
+		typical := (bar.High + bar.Low + bar.Close) / 3
+		priceVolume += typical * float64(bar.Volume)
+		volume += float64(bar.Volume)
+		if volume == 0 {
+			continue
+		}
+		resp.IndicatorValues = append(resp.IndicatorValues, types.IndicatorValue{
+			Timestamp: bar.Timestamp,
+			Values:    map[string]float64{"VWAP": priceVolume / volume},
+		})
+	}
+	return resp, nil
+}
+
+func sameDay(a, b types.OHLCV) bool {
+	ay, am, ad := a.Timestamp.Date()
+	by, bm, bd := b.Timestamp.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -1,6 +1,7 @@
 package alphainteligence
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
@@ -9,22 +10,27 @@ import (
 )
 
 func (c *AlphaInteligenceService) AnalyticsSlidingWindow(params types.AnalyticsSlidingWindowParams) (*types.AnalyticsSlidingWindowResponse, error) {
+	return c.AnalyticsSlidingWindowContext(context.Background(), params)
+}
+
+// AnalyticsSlidingWindowContext is like AnalyticsSlidingWindow but uses ctx for the underlying request.
+func (c *AlphaInteligenceService) AnalyticsSlidingWindowContext(ctx context.Context, params types.AnalyticsSlidingWindowParams) (*types.AnalyticsSlidingWindowResponse, error) {
 	symbols := strings.TrimSpace(params.Symbols)
 	interval := strings.TrimSpace(params.Interval)
 	calculations := strings.TrimSpace(params.Calculations)
 	ohlc := strings.TrimSpace(params.Ohlc)
 
 	if symbols == "" {
-		return nil, fmt.Errorf("symbols are required")
+		return nil, types.NewParameterError("ANALYTICS_SLIDING_WINDOW", "symbols", "symbols are required")
 	}
 	if interval == "" {
-		return nil, fmt.Errorf("interval is required")
+		return nil, types.NewParameterError("ANALYTICS_SLIDING_WINDOW", "interval", "interval is required")
 	}
 	if params.WindowSize == 0 {
-		return nil, fmt.Errorf("window size is required")
+		return nil, types.NewParameterError("ANALYTICS_SLIDING_WINDOW", "window_size", "window size is required")
 	}
 	if calculations == "" {
-		return nil, fmt.Errorf("calculations are required")
+		return nil, types.NewParameterError("ANALYTICS_SLIDING_WINDOW", "calculations", "calculations are required")
 	}
 
 	queryParams := url.Values{}
@@ -44,33 +50,38 @@ func (c *AlphaInteligenceService) AnalyticsSlidingWindow(params types.AnalyticsS
 		queryParams.Add("ohlc", ohlc)
 	}
 
-	data, err := c.client.Do("ANALYTICS_SLIDING_WINDOW", queryParams)
+	data, err := c.client.DoContext(ctx, "ANALYTICS_SLIDING_WINDOW", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp types.AnalyticsSlidingWindowResponse
 	if err := types.UnmarshalLenient(data, &resp); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("ANALYTICS_SLIDING_WINDOW", err)
 	}
 
 	return &resp, nil
 }
 
 func (c *AlphaInteligenceService) AnalyticsFixedWindow(params types.AnalyticsFixedWindowParams) (*types.AnalyticsFixedWindowResponse, error) {
+	return c.AnalyticsFixedWindowContext(context.Background(), params)
+}
+
+// AnalyticsFixedWindowContext is like AnalyticsFixedWindow but uses ctx for the underlying request.
+func (c *AlphaInteligenceService) AnalyticsFixedWindowContext(ctx context.Context, params types.AnalyticsFixedWindowParams) (*types.AnalyticsFixedWindowResponse, error) {
 	symbols := strings.TrimSpace(params.Symbols)
 	interval := strings.TrimSpace(params.Interval)
 	calculations := strings.TrimSpace(params.Calculations)
 	ohlc := strings.TrimSpace(params.Ohlc)
 
 	if symbols == "" {
-		return nil, fmt.Errorf("symbols are required")
+		return nil, types.NewParameterError("ANALYTICS_FIXED_WINDOW", "symbols", "symbols are required")
 	}
 	if interval == "" {
-		return nil, fmt.Errorf("interval is required")
+		return nil, types.NewParameterError("ANALYTICS_FIXED_WINDOW", "interval", "interval is required")
 	}
 	if calculations == "" {
-		return nil, fmt.Errorf("calculations are required")
+		return nil, types.NewParameterError("ANALYTICS_FIXED_WINDOW", "calculations", "calculations are required")
 	}
 
 	queryParams := url.Values{}
@@ -89,14 +100,14 @@ func (c *AlphaInteligenceService) AnalyticsFixedWindow(params types.AnalyticsFix
 		queryParams.Add("ohlc", ohlc)
 	}
 
-	data, err := c.client.Do("ANALYTICS_FIXED_WINDOW", queryParams)
+	data, err := c.client.DoContext(ctx, "ANALYTICS_FIXED_WINDOW", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp types.AnalyticsFixedWindowResponse
 	if err := types.UnmarshalLenient(data, &resp); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("ANALYTICS_FIXED_WINDOW", err)
 	}
 
 	return &resp, nil
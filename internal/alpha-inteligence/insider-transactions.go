@@ -0,0 +1,37 @@
+package alphainteligence
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// InsiderTransactions retrieves the latest and historical insider trades for a symbol.
+func (c *AlphaInteligenceService) InsiderTransactions(params types.InsiderTransactionsParams) (*types.InsiderTransactionsResponse, error) {
+	return c.InsiderTransactionsContext(context.Background(), params)
+}
+
+// InsiderTransactionsContext is like InsiderTransactions but uses ctx for the underlying request.
+func (c *AlphaInteligenceService) InsiderTransactionsContext(ctx context.Context, params types.InsiderTransactionsParams) (*types.InsiderTransactionsResponse, error) {
+	symbol := strings.TrimSpace(params.Symbol)
+	if symbol == "" {
+		return nil, types.NewParameterError("INSIDER_TRANSACTIONS", "symbol", "symbol is required")
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("symbol", symbol)
+
+	data, err := c.client.DoContext(ctx, "INSIDER_TRANSACTIONS", queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp types.InsiderTransactionsResponse
+	if err := types.UnmarshalLenient(data, &resp); err != nil {
+		return nil, types.NewDecodeError("INSIDER_TRANSACTIONS", err)
+	}
+
+	return &resp, nil
+}
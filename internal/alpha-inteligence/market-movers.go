@@ -0,0 +1,28 @@
+package alphainteligence
+
+import (
+	"context"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// TopGainersLosers retrieves the top 20 gainers, losers and most actively
+// traded US tickers for the latest trading session.
+func (c *AlphaInteligenceService) TopGainersLosers() (*types.TopGainersLosersResponse, error) {
+	return c.TopGainersLosersContext(context.Background())
+}
+
+// TopGainersLosersContext is like TopGainersLosers but uses ctx for the underlying request.
+func (c *AlphaInteligenceService) TopGainersLosersContext(ctx context.Context) (*types.TopGainersLosersResponse, error) {
+	data, err := c.client.DoContext(ctx, "TOP_GAINERS_LOSERS", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp types.TopGainersLosersResponse
+	if err := types.UnmarshalLenient(data, &resp); err != nil {
+		return nil, types.NewDecodeError("TOP_GAINERS_LOSERS", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,77 @@
+package alphainteligence
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// NewsSentiment retrieves market news articles with sentiment scores, filtered
+// by tickers, topics and publication time.
+func (c *AlphaInteligenceService) NewsSentiment(params types.NewsSentimentParams) (*types.NewsSentimentResponse, error) {
+	return c.NewsSentimentContext(context.Background(), params)
+}
+
+// NewsSentimentContext is like NewsSentiment but uses ctx for the underlying request.
+func (c *AlphaInteligenceService) NewsSentimentContext(ctx context.Context, params types.NewsSentimentParams) (*types.NewsSentimentResponse, error) {
+	if params.Limit < 0 || params.Limit > 1000 {
+		return nil, types.NewParameterError("NEWS_SENTIMENT", "limit", "limit must be between 1 and 1000 when set")
+	}
+	if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() && params.TimeTo.Before(params.TimeFrom) {
+		return nil, types.NewParameterError("NEWS_SENTIMENT", "time_to", "time to must not be before time from")
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("tickers", joinNonEmpty(params.Tickers))
+
+	topics := make([]string, len(params.Topics))
+	for i, t := range params.Topics {
+		topics[i] = string(t)
+	}
+	queryParams.Add("topics", joinNonEmpty(topics))
+
+	if !params.TimeFrom.IsZero() {
+		queryParams.Add("time_from", params.TimeFrom.UTC().Format(types.NewsTimeFormat))
+	}
+	if !params.TimeTo.IsZero() {
+		queryParams.Add("time_to", params.TimeTo.UTC().Format(types.NewsTimeFormat))
+	}
+
+	switch sort := types.NewsSort(strings.ToUpper(strings.TrimSpace(string(params.Sort)))); sort {
+	case "":
+	case types.NewsSortLatest, types.NewsSortEarliest, types.NewsSortRelevance:
+		queryParams.Add("sort", string(sort))
+	default:
+		return nil, types.NewParameterError("NEWS_SENTIMENT", "sort", "sort must be one of LATEST, EARLIEST, RELEVANCE")
+	}
+
+	if params.Limit > 0 {
+		queryParams.Add("limit", strconv.Itoa(params.Limit))
+	}
+
+	data, err := c.client.DoContext(ctx, "NEWS_SENTIMENT", queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp types.NewsSentimentResponse
+	if err := types.UnmarshalLenient(data, &resp); err != nil {
+		return nil, types.NewDecodeError("NEWS_SENTIMENT", err)
+	}
+
+	return &resp, nil
+}
+
+// joinNonEmpty trims values and joins the non-empty ones with commas.
+func joinNonEmpty(values []string) string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return strings.Join(out, ",")
+}
@@ -0,0 +1,85 @@
+package alphainteligence
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// EarningsCallTranscript retrieves the earnings call transcript for a symbol
+// and fiscal quarter, with per-passage sentiment.
+func (c *AlphaInteligenceService) EarningsCallTranscript(params types.EarningsCallTranscriptParams) (*types.EarningsCallTranscriptResponse, error) {
+	return c.EarningsCallTranscriptContext(context.Background(), params)
+}
+
+// EarningsCallTranscriptContext is like EarningsCallTranscript but uses ctx for the underlying request.
+func (c *AlphaInteligenceService) EarningsCallTranscriptContext(ctx context.Context, params types.EarningsCallTranscriptParams) (*types.EarningsCallTranscriptResponse, error) {
+	symbol := strings.TrimSpace(params.Symbol)
+	if symbol == "" {
+		return nil, types.NewParameterError("EARNINGS_CALL_TRANSCRIPT", "symbol", "symbol is required")
+	}
+	if strings.TrimSpace(params.Quarter) == "" {
+		return nil, types.NewParameterError("EARNINGS_CALL_TRANSCRIPT", "quarter", "quarter is required")
+	}
+	quarter, err := types.ParseQuarter(params.Quarter)
+	if err != nil {
+		return nil, types.NewParameterError("EARNINGS_CALL_TRANSCRIPT", "quarter", err.Error())
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("symbol", symbol)
+	queryParams.Add("quarter", quarter.String())
+
+	data, err := c.client.DoContext(ctx, "EARNINGS_CALL_TRANSCRIPT", queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp types.EarningsCallTranscriptResponse
+	if err := types.UnmarshalLenient(data, &resp); err != nil {
+		return nil, types.NewDecodeError("EARNINGS_CALL_TRANSCRIPT", err)
+	}
+
+	return &resp, nil
+}
+
+// EarningsCallTranscriptRange retrieves the transcript for every quarter
+// between params.From and params.To, oldest first, making one request per
+// quarter. Quarters that come back with an empty transcript are skipped. Any
+// other failure, including an API error message for an invalid symbol or key,
+// stops the range and returns the transcripts retrieved so far with the error.
+func (c *AlphaInteligenceService) EarningsCallTranscriptRange(params types.EarningsCallTranscriptRangeParams) ([]types.EarningsCallTranscriptResponse, error) {
+	return c.EarningsCallTranscriptRangeContext(context.Background(), params)
+}
+
+// EarningsCallTranscriptRangeContext is like EarningsCallTranscriptRange but uses ctx for the underlying requests.
+func (c *AlphaInteligenceService) EarningsCallTranscriptRangeContext(ctx context.Context, params types.EarningsCallTranscriptRangeParams) ([]types.EarningsCallTranscriptResponse, error) {
+	if strings.TrimSpace(params.Symbol) == "" {
+		return nil, types.NewParameterError("EARNINGS_CALL_TRANSCRIPT", "symbol", "symbol is required")
+	}
+	if params.From.IsZero() || params.To.IsZero() {
+		return nil, types.NewParameterError("EARNINGS_CALL_TRANSCRIPT", "quarter", "from and to are required")
+	}
+	if params.To.Before(params.From) {
+		return nil, types.NewParameterError("EARNINGS_CALL_TRANSCRIPT", "quarter", "to must not be before from")
+	}
+
+	var out []types.EarningsCallTranscriptResponse
+	for _, q := range types.QuartersBetween(params.From, params.To) {
+		resp, err := c.EarningsCallTranscriptContext(ctx, types.EarningsCallTranscriptParams{
+			Symbol:  params.Symbol,
+			Quarter: q.String(),
+		})
+		if err != nil {
+			return out, err
+		}
+		if len(resp.Transcript) == 0 {
+			continue
+		}
+		out = append(out, *resp)
+	}
+
+	return out, nil
+}
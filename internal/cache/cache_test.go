@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+type testClock struct{ now time.Time }
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func TestLRU_ExpiresEntriesAfterTTL(t *testing.T) {
+	clock := &testClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := NewLRU(10)
+	c.now = clock.Now
+
+	c.Set("a", []byte("1"), time.Hour)
+	if v, ok := c.Get("a"); !ok || string(v) != "1" {
+		t.Fatalf("expected cached value, got %q %v", v, ok)
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("expected entry to expire")
+	}
+	if c.Len() != 0 {
+		t.Fatalf("expected expired entry to be removed, len=%d", c.Len())
+	}
+}
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", []byte("1"), time.Hour)
+	c.Set("b", []byte("2"), time.Hour)
+	c.Get("a")
+	c.Set("c", []byte("3"), time.Hour)
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatalf("expected b to be evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("expected a to be retained")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Fatalf("expected c to be retained")
+	}
+}
+
+func TestLRU_IgnoresNonPositiveTTL(t *testing.T) {
+	c := NewLRU(0)
+	c.Set("a", []byte("1"), 0)
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("expected zero TTL not to be cached")
+	}
+}
+
+func TestFile_PersistsAcrossInstancesAndExpires(t *testing.T) {
+	dir := t.TempDir()
+	clock := &testClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	first, err := NewFile(dir)
+	if err != nil {
+		t.Fatalf("NewFile returned error: %v", err)
+	}
+	first.now = clock.Now
+	first.Set("function=OVERVIEW&symbol=IBM", []byte(`{"Symbol":"IBM"}`), time.Hour)
+
+	second, err := NewFile(dir)
+	if err != nil {
+		t.Fatalf("NewFile returned error: %v", err)
+	}
+	second.now = clock.Now
+
+	v, ok := second.Get("function=OVERVIEW&symbol=IBM")
+	if !ok || string(v) != `{"Symbol":"IBM"}` {
+		t.Fatalf("expected persisted value, got %q %v", v, ok)
+	}
+
+	clock.now = clock.now.Add(2 * time.Hour)
+	if _, ok := second.Get("function=OVERVIEW&symbol=IBM"); ok {
+		t.Fatalf("expected entry to expire")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir returned error: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected expired file to be removed, found %d entries", len(entries))
+	}
+}
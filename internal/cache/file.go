@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// File is a filesystem-backed cache storing one file per key under a directory.
+// Each file holds an 8-byte expiry timestamp followed by the response body, so
+// entries survive process restarts.
+type File struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFile returns a File cache rooted at dir, creating the directory if needed.
+func NewFile(dir string) (*File, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &File{dir: dir, now: time.Now}, nil
+}
+
+func (c *File) Get(key string) ([]byte, bool) {
+	path := c.path(key)
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) < 8 {
+		return nil, false
+	}
+
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(data[:8])))
+	if !c.now().Before(expires) {
+		os.Remove(path)
+		return nil, false
+	}
+
+	return data[8:], true
+}
+
+func (c *File) Set(key string, value []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	buf := make([]byte, 8+len(value))
+	binary.BigEndian.PutUint64(buf[:8], uint64(c.now().Add(ttl).UnixNano()))
+	copy(buf[8:], value)
+
+	// Write to a temp file and rename so concurrent readers never see a partial entry.
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	if _, err := tmp.Write(buf); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (c *File) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
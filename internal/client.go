@@ -1,37 +1,82 @@
 package internal
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	alphainteligence "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/alpha-inteligence"
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/commodities"
 	corestocks "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/core-stocks"
 	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/crypto"
+	economicindicators "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/economic-indicators"
 	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/forex"
 	fundamentaldata "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/fundamental-data"
+	optionsdata "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/options-data"
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/ratelimit"
 	technicalindicators "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/technical-indicators"
 	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
 )
 
 const alphaVantageURL = "https://www.alphavantage.co/query"
 
+// Config holds optional client settings. The zero value talks to the public
+// Alpha Vantage endpoint using http.DefaultClient, performs no client-side rate
+// limiting, makes a single attempt per call and does not log.
+type Config struct {
+	HTTPClient  *http.Client
+	BaseURL     string
+	UserAgent   string
+	Timeout     time.Duration
+	RateLimit   types.RateLimit
+	Retry       types.RetryPolicy
+	Cache       types.Cache
+	CachePolicy types.CachePolicy
+	Logger      *slog.Logger
+}
+
 type Client struct {
-	apiKey     string
-	httpClient *http.Client
+	apiKey      string
+	httpClient  *http.Client
+	baseURL     string
+	userAgent   string
+	timeout     time.Duration
+	limiter     *ratelimit.Limiter
+	retry       types.RetryPolicy
+	cache       types.Cache
+	cachePolicy types.CachePolicy
+	logger      *slog.Logger
 }
 
-func NewClient(apiKey string, httpClient *http.Client) Client {
+func NewClient(apiKey string, cfg Config) Client {
+	httpClient := cfg.HTTPClient
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
 
+	baseURL := strings.TrimSpace(cfg.BaseURL)
+	if baseURL == "" {
+		baseURL = alphaVantageURL
+	}
+
 	return Client{
-		apiKey:     apiKey,
-		httpClient: httpClient,
+		apiKey:      apiKey,
+		httpClient:  httpClient,
+		baseURL:     baseURL,
+		userAgent:   cfg.UserAgent,
+		timeout:     cfg.Timeout,
+		limiter:     ratelimit.New(cfg.RateLimit),
+		retry:       cfg.Retry,
+		cache:       cfg.Cache,
+		cachePolicy: cfg.CachePolicy,
+		logger:      cfg.Logger,
 	}
 }
 
@@ -40,7 +85,7 @@ func (c Client) CoreStocks() types.CoreStocks {
 }
 
 func (c Client) OptionsData() types.OptionsData {
-	return nil
+	return optionsdata.NewOptionsDataService(c)
 }
 
 func (c Client) AlphaInteligence() types.AlphaInteligence {
@@ -60,21 +105,50 @@ func (c Client) Crypto() types.Crypto {
 }
 
 func (c Client) Commodities() types.Commodities {
-	return nil
+	return commodities.NewCommoditiesService(c)
 }
 
 func (c Client) EconomicIndicators() types.EconomicIndicators {
-	return nil
+	return economicindicators.NewEconomicIndicatorsService(c)
 }
 
 func (c Client) TechnicalIndicators() types.TechnicalIndicators {
 	return technicalindicators.NewTechnicalIndicatorsService(c)
 }
 
+// Do performs an Alpha Vantage request using context.Background().
 func (c Client) Do(function string, params url.Values) ([]byte, error) {
+	return c.DoContext(context.Background(), function, params)
+}
+
+// DoContext performs an Alpha Vantage request for function with the given
+// params and returns the JSON response. The request is bound to ctx, so
+// cancelling ctx or exceeding its deadline aborts the in-flight HTTP call and
+// any pending retry. When a cache is configured, fresh cached responses are
+// returned without any network call.
+func (c Client) DoContext(ctx context.Context, function string, params url.Values) ([]byte, error) {
+	return c.do(ctx, function, "json", params)
+}
+
+// DoCSV performs a CSV Alpha Vantage request using context.Background().
+func (c Client) DoCSV(function string, params url.Values) ([]byte, error) {
+	return c.DoCSVContext(context.Background(), function, params)
+}
+
+// DoCSVContext is like DoContext for endpoints that only return CSV, such as
+// LISTING_STATUS. No datatype parameter is sent. Rate limit and error messages
+// are still reported as JSON and are returned as errors.
+func (c Client) DoCSVContext(ctx context.Context, function string, params url.Values) ([]byte, error) {
+	return c.do(ctx, function, "", params)
+}
+
+// do performs the request, adding datatype to the query when it is non-empty.
+func (c Client) do(ctx context.Context, function, datatype string, params url.Values) ([]byte, error) {
 	query := url.Values{}
 	query.Add("function", function)
-	query.Add("datatype", "json")
+	if datatype != "" {
+		query.Add("datatype", datatype)
+	}
 
 	for key, values := range params {
 		for _, v := range values {
@@ -84,12 +158,89 @@ func (c Client) Do(function string, params url.Values) ([]byte, error) {
 		}
 	}
 
+	// url.Values.Encode sorts by key, so the cache key is stable regardless of
+	// the order params were added in. It is computed before the API key is added.
+	cacheKey := query.Encode()
+	ttl := c.cacheTTL(function)
+	if ttl > 0 {
+		if data, ok := c.cache.Get(cacheKey); ok {
+			if c.logger != nil {
+				c.logger.DebugContext(ctx, "alpha vantage cache hit", "function", function)
+			}
+			return data, nil
+		}
+	}
+
 	query.Add("apikey", c.apiKey)
+	endpoint := c.baseURL + "?" + query.Encode()
+
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		data, err := c.doOnce(ctx, function, endpoint)
+		if c.logger != nil {
+			c.logger.DebugContext(ctx, "alpha vantage request",
+				"function", function, "attempt", attempt, "duration", time.Since(start), "error", err)
+		}
+		if err == nil {
+			if ttl > 0 {
+				c.cache.Set(cacheKey, data, ttl)
+			}
+			return data, nil
+		}
+
+		if !shouldRetry(ctx, c.retry, attempt, err) {
+			return nil, err
+		}
+
+		delay := backoff(c.retry, attempt)
+		if c.logger != nil {
+			c.logger.WarnContext(ctx, "alpha vantage request failed, retrying",
+				"function", function, "attempt", attempt, "backoff", delay, "error", err)
+		}
+
+		if werr := sleepContext(ctx, delay); werr != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			// The next attempt would start after the context deadline.
+			return nil, err
+		}
+	}
+}
+
+// cacheTTL returns how long responses for function may be cached, or zero when
+// no cache is configured.
+func (c Client) cacheTTL(function string) time.Duration {
+	if c.cache == nil {
+		return 0
+	}
+	return c.cachePolicy.TTL(function)
+}
+
+// doOnce performs a single rate-limited HTTP attempt against endpoint.
+func (c Client) doOnce(ctx context.Context, function, endpoint string) ([]byte, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 
-	resp, err := c.httpClient.Get(alphaVantageURL + "?" + query.Encode())
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, redactAPIKey(err)
+	}
 
 	defer resp.Body.Close()
 
@@ -98,17 +249,49 @@ func (c Client) Do(function string, params url.Values) ([]byte, error) {
 		return nil, err
 	}
 
-	if err := detectAPIMessage(data); err != nil {
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, &types.HTTPStatusError{Function: function, StatusCode: resp.StatusCode, Status: resp.Status, Body: data}
+	}
+
+	if err := detectAPIMessage(function, data); err != nil {
 		return nil, err
 	}
 
 	return data, nil
 }
 
+// redactAPIKey strips the apikey query parameter from the URL embedded in
+// transport errors so it cannot leak into logs or error messages.
+func redactAPIKey(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+
+	u, perr := url.Parse(urlErr.URL)
+	if perr != nil {
+		urlErr.URL = ""
+		return err
+	}
+
+	q := u.Query()
+	if q.Has("apikey") {
+		q.Set("apikey", "REDACTED")
+		u.RawQuery = q.Encode()
+	}
+	urlErr.URL = u.String()
+	return err
+}
+
 // detectAPIMessage inspects a raw Alpha Vantage response for top-level
 // informational or error messages (e.g., rate limits, premium endpoint notices)
-// and converts them into Go errors for callers.
-func detectAPIMessage(data []byte) error {
+// and converts them into *types.APIError values for callers.
+func detectAPIMessage(function string, data []byte) error {
+	// Skip decoding anything that is not a JSON object, such as CSV payloads.
+	if trimmed := bytes.TrimSpace(data); len(trimmed) == 0 || trimmed[0] != '{' {
+		return nil
+	}
+
 	var raw map[string]interface{}
 	if err := json.Unmarshal(data, &raw); err != nil {
 		// If the payload isn't a JSON object, let the caller's unmarshal handle it.
@@ -118,10 +301,39 @@ func detectAPIMessage(data []byte) error {
 	for _, key := range []string{"Information", "Note", "Error Message"} {
 		if v, ok := raw[key]; ok {
 			if msg, ok := v.(string); ok && strings.TrimSpace(msg) != "" {
-				return fmt.Errorf("alpha vantage %s: %s", strings.ToLower(key), msg)
+				return &types.APIError{
+					Function: function,
+					Kind:     classifyAPIMessage(key, msg),
+					Key:      key,
+					Message:  msg,
+				}
 			}
 		}
 	}
 
 	return nil
 }
+
+// classifyAPIMessage maps a top-level message to its sentinel error. Alpha
+// Vantage uses "Note" for throttling and, more recently, "Information" messages
+// that mention the request frequency or daily quota.
+func classifyAPIMessage(key, msg string) error {
+	switch key {
+	case "Note":
+		return types.ErrRateLimited
+	case "Error Message":
+		return types.ErrInvalidAPICall
+	}
+
+	lower := strings.ToLower(msg)
+	switch {
+	case strings.Contains(lower, "rate limit"),
+		strings.Contains(lower, "call frequency"),
+		strings.Contains(lower, "requests per"):
+		return types.ErrRateLimited
+	case strings.Contains(lower, "premium"):
+		return types.ErrPremiumRequired
+	default:
+		return types.ErrInformation
+	}
+}
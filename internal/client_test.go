@@ -0,0 +1,310 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+type stubResponse struct {
+	status int
+	body   string
+}
+
+// sequenceClient returns an HTTP client that serves responses in order and
+// repeats the last one once the sequence is exhausted.
+func sequenceClient(calls *int, responses ...stubResponse) *http.Client {
+	return &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			r := responses[len(responses)-1]
+			if *calls < len(responses) {
+				r = responses[*calls]
+			}
+			*calls++
+			return &http.Response{
+				StatusCode: r.status,
+				Status:     http.StatusText(r.status),
+				Body:       io.NopCloser(bytes.NewReader([]byte(r.body))),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}
+}
+
+func fastRetryPolicy(attempts int) types.RetryPolicy {
+	return types.RetryPolicy{MaxAttempts: attempts, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+}
+
+func TestDetectAPIMessage_ClassifiesMessages(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload string
+		want    error
+	}{
+		{"note", `{"Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute."}`, types.ErrRateLimited},
+		{"daily quota", `{"Information": "Our standard API rate limit is 25 requests per day. Please subscribe to any of the premium plans."}`, types.ErrRateLimited},
+		{"premium", `{"Information": "Thank you for using Alpha Vantage! This is a premium endpoint."}`, types.ErrPremiumRequired},
+		{"error message", `{"Error Message": "Invalid API call. Please retry or visit the documentation."}`, types.ErrInvalidAPICall},
+		{"other information", `{"Information": "The demo API key is for demo purposes only."}`, types.ErrInformation},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := detectAPIMessage("GLOBAL_QUOTE", []byte(tc.payload))
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, err)
+			}
+
+			var apiErr *types.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *types.APIError, got %T", err)
+			}
+			if apiErr.Function != "GLOBAL_QUOTE" || apiErr.Message == "" {
+				t.Fatalf("expected function and message to be populated, got %+v", apiErr)
+			}
+		})
+	}
+}
+
+func TestDetectAPIMessage_PassesThroughData(t *testing.T) {
+	if err := detectAPIMessage("GLOBAL_QUOTE", []byte(`{"foo": "bar"}`)); err != nil {
+		t.Fatalf("expected no error for non-message payload, got %v", err)
+	}
+	if err := detectAPIMessage("LISTING_STATUS", []byte(`symbol,name`)); err != nil {
+		t.Fatalf("expected non-JSON payload to pass through, got %v", err)
+	}
+}
+
+func TestDoContext_RetriesThrottleNote(t *testing.T) {
+	calls := 0
+	cli := NewClient("test-key", Config{
+		HTTPClient: sequenceClient(&calls,
+			stubResponse{http.StatusOK, `{"Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute."}`},
+			stubResponse{http.StatusOK, `{"ok": true}`},
+		),
+		Retry: fastRetryPolicy(3),
+	})
+
+	data, err := cli.Do("GLOBAL_QUOTE", url.Values{"symbol": {"IBM"}})
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if string(data) != `{"ok": true}` {
+		t.Fatalf("unexpected body %s", data)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestDoContext_DoesNotRetryErrorMessage(t *testing.T) {
+	calls := 0
+	cli := NewClient("test-key", Config{
+		HTTPClient: sequenceClient(&calls, stubResponse{http.StatusOK, `{"Error Message": "Invalid API call."}`}),
+		Retry:      fastRetryPolicy(3),
+	})
+
+	if _, err := cli.Do("GLOBAL_QUOTE", url.Values{"symbol": {"NOPE"}}); err == nil {
+		t.Fatalf("expected error")
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestDoContext_DoesNotRetryPremiumInformation(t *testing.T) {
+	calls := 0
+	cli := NewClient("test-key", Config{
+		HTTPClient: sequenceClient(&calls, stubResponse{http.StatusOK, `{"Information": "Thank you for using Alpha Vantage! This is a premium endpoint."}`}),
+		Retry:      fastRetryPolicy(3),
+	})
+
+	if _, err := cli.Do("REALTIME_OPTIONS", nil); err == nil {
+		t.Fatalf("expected error")
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestDoContext_RetriesServerErrorsUntilMaxAttempts(t *testing.T) {
+	calls := 0
+	cli := NewClient("test-key", Config{
+		HTTPClient: sequenceClient(&calls, stubResponse{http.StatusServiceUnavailable, ``}),
+		Retry:      fastRetryPolicy(3),
+	})
+
+	_, err := cli.Do("GLOBAL_QUOTE", nil)
+	var statusErr *types.HTTPStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 status error, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestDoContext_RespectsRetryClasses(t *testing.T) {
+	calls := 0
+	policy := fastRetryPolicy(3)
+	policy.RetryOn = types.RetryThrottle
+	cli := NewClient("test-key", Config{
+		HTTPClient: sequenceClient(&calls, stubResponse{http.StatusBadGateway, ``}),
+		Retry:      policy,
+	})
+
+	if _, err := cli.Do("GLOBAL_QUOTE", nil); err == nil {
+		t.Fatalf("expected error")
+	}
+	if calls != 1 {
+		t.Fatalf("expected server errors not to be retried, got %d attempts", calls)
+	}
+}
+
+func TestDoContext_StopsRetryingWhenContextCancelled(t *testing.T) {
+	calls := 0
+	policy := types.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Hour}
+	cli := NewClient("test-key", Config{
+		HTTPClient: sequenceClient(&calls, stubResponse{http.StatusInternalServerError, ``}),
+		Retry:      policy,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	if _, err := cli.DoContext(ctx, "GLOBAL_QUOTE", nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestBackoff_GrowsExponentiallyAndCaps(t *testing.T) {
+	policy := types.RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second, Multiplier: 2}
+
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+	for i, w := range want {
+		if got := backoff(policy, i+1); got != w {
+			t.Fatalf("attempt %d: expected %s, got %s", i+1, w, got)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := backoff(policy, 1); got < 500*time.Millisecond || got > time.Second {
+			t.Fatalf("jittered backoff out of range: %s", got)
+		}
+	}
+}
+
+type mapCache map[string][]byte
+
+func (m mapCache) Get(key string) ([]byte, bool) { v, ok := m[key]; return v, ok }
+
+func (m mapCache) Set(key string, value []byte, ttl time.Duration) { m[key] = value }
+
+func TestDoContext_ServesCachedResponsesWithinTTL(t *testing.T) {
+	calls := 0
+	cache := mapCache{}
+	cli := NewClient("test-key", Config{
+		HTTPClient:  sequenceClient(&calls, stubResponse{http.StatusOK, `{"Symbol": "IBM"}`}),
+		Cache:       cache,
+		CachePolicy: types.DefaultCachePolicy(),
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, err := cli.Do("OVERVIEW", url.Values{"symbol": {"IBM"}}); err != nil {
+			t.Fatalf("Do returned error: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 network call, got %d", calls)
+	}
+
+	for key := range cache {
+		if key != "datatype=json&function=OVERVIEW&symbol=IBM" {
+			t.Fatalf("unexpected cache key %q", key)
+		}
+	}
+}
+
+func TestDoContext_DoesNotCacheUnlistedFunctionsOrErrors(t *testing.T) {
+	calls := 0
+	cache := mapCache{}
+	cli := NewClient("test-key", Config{
+		HTTPClient: sequenceClient(&calls,
+			stubResponse{http.StatusOK, `{"Error Message": "Invalid API call."}`},
+			stubResponse{http.StatusOK, `{"Symbol": "IBM"}`},
+		),
+		Cache:       cache,
+		CachePolicy: types.DefaultCachePolicy(),
+	})
+
+	if _, err := cli.Do("OVERVIEW", url.Values{"symbol": {"IBM"}}); err == nil {
+		t.Fatalf("expected error")
+	}
+	if len(cache) != 0 {
+		t.Fatalf("expected error responses not to be cached")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := cli.Do("GLOBAL_QUOTE", url.Values{"symbol": {"IBM"}}); err != nil {
+			t.Fatalf("Do returned error: %v", err)
+		}
+	}
+	if calls != 3 {
+		t.Fatalf("expected uncached function to hit the network each time, got %d calls", calls)
+	}
+}
+
+func TestDoCSVContext_OmitsDatatypeAndDetectsJSONErrors(t *testing.T) {
+	var query url.Values
+	cli := NewClient("test-key", Config{HTTPClient: &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			body := "symbol,name,exchange,assetType,ipoDate,delistingDate,status\n"
+			if query.Get("state") == "bogus" {
+				body = `{"Error Message": "Invalid API call."}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}),
+	}})
+
+	data, err := cli.DoCSV("LISTING_STATUS", url.Values{"state": {"delisted"}})
+	if err != nil {
+		t.Fatalf("DoCSV returned error: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "symbol,") {
+		t.Fatalf("unexpected body %q", data)
+	}
+	if query.Has("datatype") {
+		t.Fatalf("expected no datatype parameter, got %q", query.Get("datatype"))
+	}
+
+	_, err = cli.DoCSV("LISTING_STATUS", url.Values{"state": {"bogus"}})
+	if !errors.Is(err, types.ErrInvalidAPICall) {
+		t.Fatalf("expected ErrInvalidAPICall, got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package commodities
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+var (
+	energyIntervals              = []types.SeriesInterval{types.SeriesDaily, types.SeriesWeekly, types.SeriesMonthly}
+	metalAndAgricultureIntervals = []types.SeriesInterval{types.SeriesMonthly, types.SeriesQuarterly, types.SeriesAnnual}
+)
+
+// WTI retrieves West Texas Intermediate (WTI) crude oil prices.
+func (c *CommoditiesService) WTI(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.WTIContext(context.Background(), params)
+}
+
+// WTIContext is like WTI but uses ctx for the underlying request.
+func (c *CommoditiesService) WTIContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "WTI", params, energyIntervals)
+}
+
+// Brent retrieves Brent (Europe) crude oil prices.
+func (c *CommoditiesService) Brent(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.BrentContext(context.Background(), params)
+}
+
+// BrentContext is like Brent but uses ctx for the underlying request.
+func (c *CommoditiesService) BrentContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "BRENT", params, energyIntervals)
+}
+
+// NaturalGas retrieves Henry Hub natural gas spot prices.
+func (c *CommoditiesService) NaturalGas(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.NaturalGasContext(context.Background(), params)
+}
+
+// NaturalGasContext is like NaturalGas but uses ctx for the underlying request.
+func (c *CommoditiesService) NaturalGasContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "NATURAL_GAS", params, energyIntervals)
+}
+
+// Copper retrieves global copper prices.
+func (c *CommoditiesService) Copper(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.CopperContext(context.Background(), params)
+}
+
+// CopperContext is like Copper but uses ctx for the underlying request.
+func (c *CommoditiesService) CopperContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "COPPER", params, metalAndAgricultureIntervals)
+}
+
+// Aluminum retrieves global aluminum prices.
+func (c *CommoditiesService) Aluminum(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.AluminumContext(context.Background(), params)
+}
+
+// AluminumContext is like Aluminum but uses ctx for the underlying request.
+func (c *CommoditiesService) AluminumContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "ALUMINUM", params, metalAndAgricultureIntervals)
+}
+
+// Wheat retrieves global wheat prices.
+func (c *CommoditiesService) Wheat(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.WheatContext(context.Background(), params)
+}
+
+// WheatContext is like Wheat but uses ctx for the underlying request.
+func (c *CommoditiesService) WheatContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "WHEAT", params, metalAndAgricultureIntervals)
+}
+
+// Corn retrieves global corn prices.
+func (c *CommoditiesService) Corn(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.CornContext(context.Background(), params)
+}
+
+// CornContext is like Corn but uses ctx for the underlying request.
+func (c *CommoditiesService) CornContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "CORN", params, metalAndAgricultureIntervals)
+}
+
+// Cotton retrieves global cotton prices.
+func (c *CommoditiesService) Cotton(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.CottonContext(context.Background(), params)
+}
+
+// CottonContext is like Cotton but uses ctx for the underlying request.
+func (c *CommoditiesService) CottonContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "COTTON", params, metalAndAgricultureIntervals)
+}
+
+// Sugar retrieves global sugar prices.
+func (c *CommoditiesService) Sugar(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.SugarContext(context.Background(), params)
+}
+
+// SugarContext is like Sugar but uses ctx for the underlying request.
+func (c *CommoditiesService) SugarContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "SUGAR", params, metalAndAgricultureIntervals)
+}
+
+// Coffee retrieves global coffee prices.
+func (c *CommoditiesService) Coffee(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.CoffeeContext(context.Background(), params)
+}
+
+// CoffeeContext is like Coffee but uses ctx for the underlying request.
+func (c *CommoditiesService) CoffeeContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "COFFEE", params, metalAndAgricultureIntervals)
+}
+
+// AllCommodities retrieves the global price index of all commodities.
+func (c *CommoditiesService) AllCommodities(params types.CommodityParams) (*types.DataSeries, error) {
+	return c.AllCommoditiesContext(context.Background(), params)
+}
+
+// AllCommoditiesContext is like AllCommodities but uses ctx for the underlying request.
+func (c *CommoditiesService) AllCommoditiesContext(ctx context.Context, params types.CommodityParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "ALL_COMMODITIES", params, metalAndAgricultureIntervals)
+}
+
+func (c *CommoditiesService) getSeries(ctx context.Context, function string, params types.CommodityParams, allowed []types.SeriesInterval) (*types.DataSeries, error) {
+	interval, err := types.CheckSeriesInterval(function, params.Interval, allowed...)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("interval", string(interval))
+
+	data, err := c.client.DoContext(ctx, function, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var series types.DataSeries
+	if err := types.UnmarshalLenient(data, &series); err != nil {
+		return nil, types.NewDecodeError(function, err)
+	}
+
+	return &series, nil
+}
@@ -0,0 +1,11 @@
+package commodities
+
+import itypes "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/types"
+
+type CommoditiesService struct {
+	client itypes.Client
+}
+
+func NewCommoditiesService(client itypes.Client) *CommoditiesService {
+	return &CommoditiesService{client: client}
+}
@@ -0,0 +1,84 @@
+package corestocks
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// BulkQuotes retrieves realtime quotes for any number of symbols, issuing one
+// REALTIME_BULK_QUOTES request per 100 symbols. This is a premium endpoint.
+//
+// A failed request does not stop the remaining ones; its symbols are reported
+// in the result's Errors instead. The returned error is only non-nil when no
+// symbols are given or ctx is done.
+func (c *CoreStucksService) BulkQuotes(symbols []string) (*types.BulkQuotesResult, error) {
+	return c.BulkQuotesContext(context.Background(), symbols)
+}
+
+// BulkQuotesContext is like BulkQuotes but uses ctx for the underlying requests.
+func (c *CoreStucksService) BulkQuotesContext(ctx context.Context, symbols []string) (*types.BulkQuotesResult, error) {
+	unique := make([]string, 0, len(symbols))
+	seen := make(map[string]bool, len(symbols))
+	for _, s := range symbols {
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if s != "" && !seen[s] {
+			seen[s] = true
+			unique = append(unique, s)
+		}
+	}
+	if len(unique) == 0 {
+		return nil, types.NewParameterError("REALTIME_BULK_QUOTES", "symbol", "at least one symbol is required")
+	}
+
+	result := &types.BulkQuotesResult{
+		Quotes: make(map[string]types.BulkQuote, len(unique)),
+		Errors: make(map[string]error),
+	}
+
+	for start := 0; start < len(unique); start += types.BulkQuotesChunkSize {
+		chunk := unique[start:min(start+types.BulkQuotesChunkSize, len(unique))]
+
+		resp, err := c.bulkQuotes(ctx, chunk)
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+			for _, s := range chunk {
+				result.Errors[s] = err
+			}
+			continue
+		}
+
+		for _, q := range resp.Data {
+			result.Quotes[strings.ToUpper(q.Symbol)] = q
+		}
+		for _, s := range chunk {
+			if _, ok := result.Quotes[s]; !ok {
+				result.Errors[s] = fmt.Errorf("%w: %s", types.ErrSymbolNotReturned, s)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (c *CoreStucksService) bulkQuotes(ctx context.Context, symbols []string) (*types.BulkQuotesResponse, error) {
+	queryParams := url.Values{}
+	queryParams.Add("symbol", strings.Join(symbols, ","))
+
+	data, err := c.client.DoContext(ctx, "REALTIME_BULK_QUOTES", queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp types.BulkQuotesResponse
+	if err := types.UnmarshalLenient(data, &resp); err != nil {
+		return nil, types.NewDecodeError("REALTIME_BULK_QUOTES", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,28 @@
+package corestocks
+
+import (
+	"context"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// MarketStatus retrieves the current open/closed status of major equity,
+// forex and cryptocurrency markets around the world.
+func (c *CoreStucksService) MarketStatus() (*types.MarketStatusResponse, error) {
+	return c.MarketStatusContext(context.Background())
+}
+
+// MarketStatusContext is like MarketStatus but uses ctx for the underlying request.
+func (c *CoreStucksService) MarketStatusContext(ctx context.Context) (*types.MarketStatusResponse, error) {
+	data, err := c.client.DoContext(ctx, "MARKET_STATUS", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status types.MarketStatusResponse
+	if err := types.UnmarshalLenient(data, &status); err != nil {
+		return nil, types.NewDecodeError("MARKET_STATUS", err)
+	}
+
+	return &status, nil
+}
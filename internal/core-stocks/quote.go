@@ -1,7 +1,7 @@
 package corestocks
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
@@ -11,22 +11,40 @@ import (
 // Quote retrieves the quote endpoint based on the provided parameters.
 // It returns a Quote and an error if there is any.
 func (c *CoreStucksService) Quote(symbol string) (types.Quote, error) {
-	symbol = strings.TrimSpace(symbol)
+	return c.QuoteContext(context.Background(), symbol)
+}
+
+// QuoteContext is like Quote but uses ctx for the underlying request.
+func (c *CoreStucksService) QuoteContext(ctx context.Context, symbol string) (types.Quote, error) {
+	return c.QuoteWithParamsContext(ctx, types.QuoteParams{Symbol: symbol})
+}
+
+// QuoteWithParams is like Quote but accepts the optional entitlement parameter.
+func (c *CoreStucksService) QuoteWithParams(params types.QuoteParams) (types.Quote, error) {
+	return c.QuoteWithParamsContext(context.Background(), params)
+}
+
+// QuoteWithParamsContext is like QuoteWithParams but uses ctx for the underlying request.
+func (c *CoreStucksService) QuoteWithParamsContext(ctx context.Context, params types.QuoteParams) (types.Quote, error) {
+	symbol := strings.TrimSpace(params.Symbol)
 	if symbol == "" {
-		return types.Quote{}, fmt.Errorf("symbol is required")
+		return types.Quote{}, types.NewParameterError("GLOBAL_QUOTE", "symbol", "symbol is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
+	if err := addEntitlement(queryParams, "GLOBAL_QUOTE", params.Entitlement); err != nil {
+		return types.Quote{}, err
+	}
 
-	data, err := c.client.Do("GLOBAL_QUOTE", queryParams)
+	data, err := c.client.DoContext(ctx, "GLOBAL_QUOTE", queryParams)
 	if err != nil {
 		return types.Quote{}, err
 	}
 
 	var quote types.Quote
 	if err := types.UnmarshalLenient(data, &quote); err != nil {
-		return types.Quote{}, err
+		return types.Quote{}, types.NewDecodeError("GLOBAL_QUOTE", err)
 	}
 
 	return quote, nil
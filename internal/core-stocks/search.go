@@ -1,7 +1,7 @@
 package corestocks
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
@@ -10,22 +10,27 @@ import (
 
 // SymbolSearch retrieves the best-matching symbols and market information based on keywords.
 func (c *CoreStucksService) SymbolSearch(keywords string) (*types.SymbolSearchResponse, error) {
+	return c.SymbolSearchContext(context.Background(), keywords)
+}
+
+// SymbolSearchContext is like SymbolSearch but uses ctx for the underlying request.
+func (c *CoreStucksService) SymbolSearchContext(ctx context.Context, keywords string) (*types.SymbolSearchResponse, error) {
 	keywords = strings.TrimSpace(keywords)
 	if keywords == "" {
-		return nil, fmt.Errorf("keywords is required")
+		return nil, types.NewParameterError("SYMBOL_SEARCH", "keywords", "keywords is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("keywords", keywords)
 
-	data, err := c.client.Do("SYMBOL_SEARCH", queryParams)
+	data, err := c.client.DoContext(ctx, "SYMBOL_SEARCH", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var search types.SymbolSearchResponse
 	if err := types.UnmarshalLenient(data, &search); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("SYMBOL_SEARCH", err)
 	}
 
 	return &search, nil
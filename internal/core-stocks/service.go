@@ -1,8 +1,9 @@
 package corestocks
 
 import (
-	"fmt"
+	"context"
 	"net/url"
+	"strconv"
 	"strings"
 
 	itypes "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/types"
@@ -18,47 +19,66 @@ func NewCoreStocksService(client itypes.Client) *CoreStucksService {
 }
 
 // getTimeSeriesData retrieves time series data based on the provided parameters.
-func (c *CoreStucksService) getTimeSeriesData(function string, params types.TimeSeriesParams) ([]byte, error) {
+func (c *CoreStucksService) getTimeSeriesData(ctx context.Context, function string, params types.TimeSeriesParams) ([]byte, error) {
 	symbol := strings.TrimSpace(params.Symbol)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError(function, "symbol", "symbol is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 
-	interval := strings.TrimSpace(params.Interval)
-	if interval != "" {
-		queryParams.Add("interval", interval)
+	if params.Interval != "" {
+		interval, err := types.CheckInterval(function, params.Interval, function == "TIME_SERIES_INTRADAY")
+		if err != nil {
+			return nil, err
+		}
+		queryParams.Add("interval", string(interval))
 	}
 
-	if monthStr, ok := params.Month.(string); ok {
-		monthStr = strings.TrimSpace(monthStr)
-		if monthStr != "" {
-			queryParams.Add("month", monthStr)
+	if !params.Month.IsZero() {
+		if function != "TIME_SERIES_INTRADAY" {
+			return nil, types.NewParameterError(function, "month", "month applies to intraday series only")
 		}
-	} else if monthPtr, ok := params.Month.(*string); ok {
-		if monthPtr != nil {
-			month := strings.TrimSpace(*monthPtr)
-			if month != "" {
-				queryParams.Add("month", month)
-			}
+		if err := types.CheckMonth(function, params.Month); err != nil {
+			return nil, err
 		}
+		queryParams.Add("month", params.Month.String())
+	}
+
+	outputSize, err := types.CheckOutputSize(function, params.OutputSize)
+	if err != nil {
+		return nil, err
 	}
+	queryParams.Add("outputsize", string(outputSize))
 
-	if outputStr, ok := params.OutputSize.(string); ok {
-		outputStr = strings.TrimSpace(outputStr)
-		if outputStr != "" {
-			queryParams.Add("outputsize", outputStr)
+	if params.Adjusted != nil || params.ExtendedHours != nil {
+		if function != "TIME_SERIES_INTRADAY" {
+			return nil, types.NewParameterError(function, "adjusted", "adjusted and extended hours apply to intraday series only")
 		}
-	} else if outputPtr, ok := params.OutputSize.(*string); ok {
-		if outputPtr != nil {
-			outputSize := strings.TrimSpace(*outputPtr)
-			if outputSize != "" {
-				queryParams.Add("outputsize", outputSize)
-			}
+		if params.Adjusted != nil {
+			queryParams.Add("adjusted", strconv.FormatBool(*params.Adjusted))
+		}
+		if params.ExtendedHours != nil {
+			queryParams.Add("extended_hours", strconv.FormatBool(*params.ExtendedHours))
 		}
 	}
 
-	return c.client.Do(function, queryParams)
+	if err := addEntitlement(queryParams, function, params.Entitlement); err != nil {
+		return nil, err
+	}
+
+	return c.client.DoContext(ctx, function, queryParams)
+}
+
+// addEntitlement validates entitlement and adds it to queryParams when set.
+func addEntitlement(queryParams url.Values, function string, entitlement types.Entitlement) error {
+	switch e := types.Entitlement(strings.ToLower(strings.TrimSpace(string(entitlement)))); e {
+	case "":
+	case types.EntitlementRealtime, types.EntitlementDelayed:
+		queryParams.Add("entitlement", string(e))
+	default:
+		return types.NewParameterError(function, "entitlement", "entitlement must be realtime or delayed")
+	}
+	return nil
 }
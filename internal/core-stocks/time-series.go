@@ -1,8 +1,7 @@
 package corestocks
 
 import (
-	"fmt"
-	"strings"
+	"context"
 
 	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
 )
@@ -10,11 +9,16 @@ import (
 // Intraday retrieves intraday data based on the provided parameters.
 // It returns a TimeSeriesIntraday and an error if there is any.
 func (c *CoreStucksService) Intraday(params types.TimeSeriesParams) (types.TimeSeriesIntraday, error) {
-	if strings.TrimSpace(params.Interval) == "" {
-		return types.TimeSeriesIntraday{}, fmt.Errorf("interval is required")
+	return c.IntradayContext(context.Background(), params)
+}
+
+// IntradayContext is like Intraday but uses ctx for the underlying request.
+func (c *CoreStucksService) IntradayContext(ctx context.Context, params types.TimeSeriesParams) (types.TimeSeriesIntraday, error) {
+	if _, err := types.CheckInterval("TIME_SERIES_INTRADAY", params.Interval, true); err != nil {
+		return types.TimeSeriesIntraday{}, err
 	}
 
-	data, err := c.getTimeSeriesData("TIME_SERIES_INTRADAY", params)
+	data, err := c.getTimeSeriesData(ctx, "TIME_SERIES_INTRADAY", params)
 	if err != nil {
 		return types.TimeSeriesIntraday{}, err
 	}
@@ -22,7 +26,7 @@ func (c *CoreStucksService) Intraday(params types.TimeSeriesParams) (types.TimeS
 	var intradayData types.TimeSeriesIntraday
 	err = types.UnmarshalLenient(data, &intradayData)
 	if err != nil {
-		return types.TimeSeriesIntraday{}, err
+		return types.TimeSeriesIntraday{}, types.NewDecodeError("TIME_SERIES_INTRADAY", err)
 	}
 
 	return intradayData, nil
@@ -31,7 +35,12 @@ func (c *CoreStucksService) Intraday(params types.TimeSeriesParams) (types.TimeS
 // Daily retrieves daily data based on the provided parameters.
 // It returns a TimeSeriesDaily and an error if there is any.
 func (c *CoreStucksService) Daily(params types.TimeSeriesParams) (types.TimeSeriesDaily, error) {
-	data, err := c.getTimeSeriesData("TIME_SERIES_DAILY", params)
+	return c.DailyContext(context.Background(), params)
+}
+
+// DailyContext is like Daily but uses ctx for the underlying request.
+func (c *CoreStucksService) DailyContext(ctx context.Context, params types.TimeSeriesParams) (types.TimeSeriesDaily, error) {
+	data, err := c.getTimeSeriesData(ctx, "TIME_SERIES_DAILY", params)
 	if err != nil {
 		return types.TimeSeriesDaily{}, err
 	}
@@ -39,7 +48,7 @@ func (c *CoreStucksService) Daily(params types.TimeSeriesParams) (types.TimeSeri
 	var dailyData types.TimeSeriesDaily
 	err = types.UnmarshalLenient(data, &dailyData)
 	if err != nil {
-		return types.TimeSeriesDaily{}, err
+		return types.TimeSeriesDaily{}, types.NewDecodeError("TIME_SERIES_DAILY", err)
 	}
 
 	return dailyData, nil
@@ -48,7 +57,12 @@ func (c *CoreStucksService) Daily(params types.TimeSeriesParams) (types.TimeSeri
 // DailyAdjusted retrieves daily adjusted data based on the provided parameters.
 // It returns a TimeSeriesDailyAdjusted and an error if there is any.
 func (c *CoreStucksService) DailyAdjusted(params types.TimeSeriesParams) (types.TimeSeriesDailyAdjusted, error) {
-	data, err := c.getTimeSeriesData("TIME_SERIES_DAILY_ADJUSTED", params)
+	return c.DailyAdjustedContext(context.Background(), params)
+}
+
+// DailyAdjustedContext is like DailyAdjusted but uses ctx for the underlying request.
+func (c *CoreStucksService) DailyAdjustedContext(ctx context.Context, params types.TimeSeriesParams) (types.TimeSeriesDailyAdjusted, error) {
+	data, err := c.getTimeSeriesData(ctx, "TIME_SERIES_DAILY_ADJUSTED", params)
 	if err != nil {
 		return types.TimeSeriesDailyAdjusted{}, err
 	}
@@ -56,7 +70,7 @@ func (c *CoreStucksService) DailyAdjusted(params types.TimeSeriesParams) (types.
 	var dailyAdjustedData types.TimeSeriesDailyAdjusted
 	err = types.UnmarshalLenient(data, &dailyAdjustedData)
 	if err != nil {
-		return types.TimeSeriesDailyAdjusted{}, err
+		return types.TimeSeriesDailyAdjusted{}, types.NewDecodeError("TIME_SERIES_DAILY_ADJUSTED", err)
 	}
 	return dailyAdjustedData, nil
 }
@@ -64,7 +78,12 @@ func (c *CoreStucksService) DailyAdjusted(params types.TimeSeriesParams) (types.
 // Weekly retrieves weekly data based on the provided parameters.
 // It returns a TimeSeriesWeekly and an error if there is any.
 func (c *CoreStucksService) Weekly(params types.TimeSeriesParams) (types.TimeSeriesWeekly, error) {
-	data, err := c.getTimeSeriesData("TIME_SERIES_WEEKLY", params)
+	return c.WeeklyContext(context.Background(), params)
+}
+
+// WeeklyContext is like Weekly but uses ctx for the underlying request.
+func (c *CoreStucksService) WeeklyContext(ctx context.Context, params types.TimeSeriesParams) (types.TimeSeriesWeekly, error) {
+	data, err := c.getTimeSeriesData(ctx, "TIME_SERIES_WEEKLY", params)
 	if err != nil {
 		return types.TimeSeriesWeekly{}, err
 	}
@@ -72,7 +91,7 @@ func (c *CoreStucksService) Weekly(params types.TimeSeriesParams) (types.TimeSer
 	var weeklyData types.TimeSeriesWeekly
 	err = types.UnmarshalLenient(data, &weeklyData)
 	if err != nil {
-		return types.TimeSeriesWeekly{}, err
+		return types.TimeSeriesWeekly{}, types.NewDecodeError("TIME_SERIES_WEEKLY", err)
 	}
 	return weeklyData, nil
 }
@@ -80,7 +99,12 @@ func (c *CoreStucksService) Weekly(params types.TimeSeriesParams) (types.TimeSer
 // WeeklyAdjusted retrieves weekly adjusted data based on the provided parameters.
 // It returns a TimeSeriesWeekly and an error if there is any.
 func (c *CoreStucksService) WeeklyAdjusted(params types.TimeSeriesParams) (types.TimeSeriesWeekly, error) {
-	data, err := c.getTimeSeriesData("TIME_SERIES_WEEKLY_ADJUSTED", params)
+	return c.WeeklyAdjustedContext(context.Background(), params)
+}
+
+// WeeklyAdjustedContext is like WeeklyAdjusted but uses ctx for the underlying request.
+func (c *CoreStucksService) WeeklyAdjustedContext(ctx context.Context, params types.TimeSeriesParams) (types.TimeSeriesWeekly, error) {
+	data, err := c.getTimeSeriesData(ctx, "TIME_SERIES_WEEKLY_ADJUSTED", params)
 	if err != nil {
 		return types.TimeSeriesWeekly{}, err
 	}
@@ -88,7 +112,7 @@ func (c *CoreStucksService) WeeklyAdjusted(params types.TimeSeriesParams) (types
 	var weeklyAdjustedData types.TimeSeriesWeekly
 	err = types.UnmarshalLenient(data, &weeklyAdjustedData)
 	if err != nil {
-		return types.TimeSeriesWeekly{}, err
+		return types.TimeSeriesWeekly{}, types.NewDecodeError("TIME_SERIES_WEEKLY_ADJUSTED", err)
 	}
 	return weeklyAdjustedData, nil
 }
@@ -96,7 +120,12 @@ func (c *CoreStucksService) WeeklyAdjusted(params types.TimeSeriesParams) (types
 // Monthly retrieves monthly data based on the provided parameters.
 // It returns a TimeSeriesMonthly and an error if there is any.
 func (c *CoreStucksService) Monthly(params types.TimeSeriesParams) (types.TimeSeriesMonthly, error) {
-	data, err := c.getTimeSeriesData("TIME_SERIES_MONTHLY", params)
+	return c.MonthlyContext(context.Background(), params)
+}
+
+// MonthlyContext is like Monthly but uses ctx for the underlying request.
+func (c *CoreStucksService) MonthlyContext(ctx context.Context, params types.TimeSeriesParams) (types.TimeSeriesMonthly, error) {
+	data, err := c.getTimeSeriesData(ctx, "TIME_SERIES_MONTHLY", params)
 	if err != nil {
 		return types.TimeSeriesMonthly{}, err
 	}
@@ -104,7 +133,7 @@ func (c *CoreStucksService) Monthly(params types.TimeSeriesParams) (types.TimeSe
 	var monthlyData types.TimeSeriesMonthly
 	err = types.UnmarshalLenient(data, &monthlyData)
 	if err != nil {
-		return types.TimeSeriesMonthly{}, err
+		return types.TimeSeriesMonthly{}, types.NewDecodeError("TIME_SERIES_MONTHLY", err)
 	}
 	return monthlyData, nil
 }
@@ -112,7 +141,12 @@ func (c *CoreStucksService) Monthly(params types.TimeSeriesParams) (types.TimeSe
 // MonthlyAdjusted retrieves monthly adjusted data based on the provided parameters.
 // It returns a TimeSeriesMonthlyAdjusted and an error if there is any.
 func (c *CoreStucksService) MonthlyAdjusted(params types.TimeSeriesParams) (types.TimeSeriesMonthlyAdjusted, error) {
-	data, err := c.getTimeSeriesData("TIME_SERIES_MONTHLY_ADJUSTED", params)
+	return c.MonthlyAdjustedContext(context.Background(), params)
+}
+
+// MonthlyAdjustedContext is like MonthlyAdjusted but uses ctx for the underlying request.
+func (c *CoreStucksService) MonthlyAdjustedContext(ctx context.Context, params types.TimeSeriesParams) (types.TimeSeriesMonthlyAdjusted, error) {
+	data, err := c.getTimeSeriesData(ctx, "TIME_SERIES_MONTHLY_ADJUSTED", params)
 	if err != nil {
 		return types.TimeSeriesMonthlyAdjusted{}, err
 	}
@@ -120,7 +154,7 @@ func (c *CoreStucksService) MonthlyAdjusted(params types.TimeSeriesParams) (type
 	var monthlyAdjustedData types.TimeSeriesMonthlyAdjusted
 	err = types.UnmarshalLenient(data, &monthlyAdjustedData)
 	if err != nil {
-		return types.TimeSeriesMonthlyAdjusted{}, err
+		return types.TimeSeriesMonthlyAdjusted{}, types.NewDecodeError("TIME_SERIES_MONTHLY_ADJUSTED", err)
 	}
 	return monthlyAdjustedData, nil
 }
@@ -1,7 +1,7 @@
 package crypto
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
@@ -9,118 +9,139 @@ import (
 )
 
 func (c *CryptoService) Intraday(params types.CryptoIntradayParams) (*types.CryptoSeriesResponse, error) {
+	return c.IntradayContext(context.Background(), params)
+}
+
+// IntradayContext is like Intraday but uses ctx for the underlying request.
+func (c *CryptoService) IntradayContext(ctx context.Context, params types.CryptoIntradayParams) (*types.CryptoSeriesResponse, error) {
 	symbol := strings.TrimSpace(params.Symbol)
 	market := strings.TrimSpace(params.Market)
-	interval := strings.TrimSpace(params.Interval)
-	outputSize := strings.TrimSpace(params.OutputSize)
 
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("CRYPTO_INTRADAY", "symbol", "symbol is required")
 	}
 	if market == "" {
-		return nil, fmt.Errorf("market is required")
+		return nil, types.NewParameterError("CRYPTO_INTRADAY", "market", "market is required")
 	}
-	if interval == "" {
-		return nil, fmt.Errorf("interval is required")
+	interval, err := types.CheckInterval("CRYPTO_INTRADAY", params.Interval, true)
+	if err != nil {
+		return nil, err
+	}
+	outputSize, err := types.CheckOutputSize("CRYPTO_INTRADAY", params.OutputSize)
+	if err != nil {
+		return nil, err
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 	queryParams.Add("market", market)
-	queryParams.Add("interval", interval)
-	if outputSize != "" {
-		queryParams.Add("outputsize", outputSize)
-	}
+	queryParams.Add("interval", string(interval))
+	queryParams.Add("outputsize", string(outputSize))
 
-	data, err := c.client.Do("CRYPTO_INTRADAY", queryParams)
+	data, err := c.client.DoContext(ctx, "CRYPTO_INTRADAY", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	cryptoData := &types.CryptoSeriesResponse{}
 	if err := types.UnmarshalCryptoJSON(cryptoData, data); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("CRYPTO_INTRADAY", err)
 	}
 
 	return cryptoData, nil
 }
 
 func (c *CryptoService) Daily(params types.CryptoDailyParams) (*types.CryptoSeriesResponse, error) {
+	return c.DailyContext(context.Background(), params)
+}
+
+// DailyContext is like Daily but uses ctx for the underlying request.
+func (c *CryptoService) DailyContext(ctx context.Context, params types.CryptoDailyParams) (*types.CryptoSeriesResponse, error) {
 	symbol := strings.TrimSpace(params.Symbol)
 	market := strings.TrimSpace(params.Market)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("DIGITAL_CURRENCY_DAILY", "symbol", "symbol is required")
 	}
 	if market == "" {
-		return nil, fmt.Errorf("market is required")
+		return nil, types.NewParameterError("DIGITAL_CURRENCY_DAILY", "market", "market is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 	queryParams.Add("market", market)
 
-	data, err := c.client.Do("DIGITAL_CURRENCY_DAILY", queryParams)
+	data, err := c.client.DoContext(ctx, "DIGITAL_CURRENCY_DAILY", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	cryptoData := &types.CryptoSeriesResponse{}
 	if err := types.UnmarshalCryptoJSON(cryptoData, data); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("DIGITAL_CURRENCY_DAILY", err)
 	}
 
 	return cryptoData, nil
 }
 
 func (c *CryptoService) Weekly(params types.CryptoWeeklyParams) (*types.CryptoSeriesResponse, error) {
+	return c.WeeklyContext(context.Background(), params)
+}
+
+// WeeklyContext is like Weekly but uses ctx for the underlying request.
+func (c *CryptoService) WeeklyContext(ctx context.Context, params types.CryptoWeeklyParams) (*types.CryptoSeriesResponse, error) {
 	symbol := strings.TrimSpace(params.Symbol)
 	market := strings.TrimSpace(params.Market)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("DIGITAL_CURRENCY_WEEKLY", "symbol", "symbol is required")
 	}
 	if market == "" {
-		return nil, fmt.Errorf("market is required")
+		return nil, types.NewParameterError("DIGITAL_CURRENCY_WEEKLY", "market", "market is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 	queryParams.Add("market", market)
 
-	data, err := c.client.Do("DIGITAL_CURRENCY_WEEKLY", queryParams)
+	data, err := c.client.DoContext(ctx, "DIGITAL_CURRENCY_WEEKLY", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	cryptoData := &types.CryptoSeriesResponse{}
 	if err := types.UnmarshalCryptoJSON(cryptoData, data); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("DIGITAL_CURRENCY_WEEKLY", err)
 	}
 
 	return cryptoData, nil
 }
 
 func (c *CryptoService) Monthly(params types.CryptoMonthlyParams) (*types.CryptoSeriesResponse, error) {
+	return c.MonthlyContext(context.Background(), params)
+}
+
+// MonthlyContext is like Monthly but uses ctx for the underlying request.
+func (c *CryptoService) MonthlyContext(ctx context.Context, params types.CryptoMonthlyParams) (*types.CryptoSeriesResponse, error) {
 	symbol := strings.TrimSpace(params.Symbol)
 	market := strings.TrimSpace(params.Market)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("DIGITAL_CURRENCY_MONTHLY", "symbol", "symbol is required")
 	}
 	if market == "" {
-		return nil, fmt.Errorf("market is required")
+		return nil, types.NewParameterError("DIGITAL_CURRENCY_MONTHLY", "market", "market is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 	queryParams.Add("market", market)
 
-	data, err := c.client.Do("DIGITAL_CURRENCY_MONTHLY", queryParams)
+	data, err := c.client.DoContext(ctx, "DIGITAL_CURRENCY_MONTHLY", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	cryptoData := &types.CryptoSeriesResponse{}
 	if err := types.UnmarshalCryptoJSON(cryptoData, data); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("DIGITAL_CURRENCY_MONTHLY", err)
 	}
 
 	return cryptoData, nil
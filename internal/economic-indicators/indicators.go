@@ -0,0 +1,154 @@
+package economicindicators
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// RealGDP retrieves the quarterly or annual real GDP of the United States.
+func (c *EconomicIndicatorsService) RealGDP(params types.EconomicIndicatorParams) (*types.DataSeries, error) {
+	return c.RealGDPContext(context.Background(), params)
+}
+
+// RealGDPContext is like RealGDP but uses ctx for the underlying request.
+func (c *EconomicIndicatorsService) RealGDPContext(ctx context.Context, params types.EconomicIndicatorParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "REAL_GDP", params.Interval, types.SeriesQuarterly, types.SeriesAnnual)
+}
+
+// RealGDPPerCapita retrieves the quarterly real GDP per capita of the United States.
+func (c *EconomicIndicatorsService) RealGDPPerCapita() (*types.DataSeries, error) {
+	return c.RealGDPPerCapitaContext(context.Background())
+}
+
+// RealGDPPerCapitaContext is like RealGDPPerCapita but uses ctx for the underlying request.
+func (c *EconomicIndicatorsService) RealGDPPerCapitaContext(ctx context.Context) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "REAL_GDP_PER_CAPITA", "")
+}
+
+// TreasuryYield retrieves the US treasury yield for the requested maturity.
+func (c *EconomicIndicatorsService) TreasuryYield(params types.TreasuryYieldParams) (*types.DataSeries, error) {
+	return c.TreasuryYieldContext(context.Background(), params)
+}
+
+// TreasuryYieldContext is like TreasuryYield but uses ctx for the underlying request.
+func (c *EconomicIndicatorsService) TreasuryYieldContext(ctx context.Context, params types.TreasuryYieldParams) (*types.DataSeries, error) {
+	interval, err := types.CheckSeriesInterval("TREASURY_YIELD", params.Interval, types.SeriesDaily, types.SeriesWeekly, types.SeriesMonthly)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("interval", string(interval))
+
+	switch maturity := types.TreasuryMaturity(strings.ToLower(strings.TrimSpace(string(params.Maturity)))); maturity {
+	case "":
+	case types.Maturity3Month, types.Maturity2Year, types.Maturity5Year,
+		types.Maturity7Year, types.Maturity10Year, types.Maturity30Year:
+		queryParams.Add("maturity", string(maturity))
+	default:
+		return nil, types.NewParameterError("TREASURY_YIELD", "maturity", "maturity must be one of 3month, 2year, 5year, 7year, 10year, 30year")
+	}
+
+	return c.fetch(ctx, "TREASURY_YIELD", queryParams)
+}
+
+// FederalFundsRate retrieves the effective federal funds interest rate.
+func (c *EconomicIndicatorsService) FederalFundsRate(params types.EconomicIndicatorParams) (*types.DataSeries, error) {
+	return c.FederalFundsRateContext(context.Background(), params)
+}
+
+// FederalFundsRateContext is like FederalFundsRate but uses ctx for the underlying request.
+func (c *EconomicIndicatorsService) FederalFundsRateContext(ctx context.Context, params types.EconomicIndicatorParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "FEDERAL_FUNDS_RATE", params.Interval, types.SeriesDaily, types.SeriesWeekly, types.SeriesMonthly)
+}
+
+// CPI retrieves the US consumer price index for all urban consumers.
+func (c *EconomicIndicatorsService) CPI(params types.EconomicIndicatorParams) (*types.DataSeries, error) {
+	return c.CPIContext(context.Background(), params)
+}
+
+// CPIContext is like CPI but uses ctx for the underlying request.
+func (c *EconomicIndicatorsService) CPIContext(ctx context.Context, params types.EconomicIndicatorParams) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "CPI", params.Interval, types.SeriesMonthly, types.SeriesSemiannual)
+}
+
+// Inflation retrieves the annual US inflation rate.
+func (c *EconomicIndicatorsService) Inflation() (*types.DataSeries, error) {
+	return c.InflationContext(context.Background())
+}
+
+// InflationContext is like Inflation but uses ctx for the underlying request.
+func (c *EconomicIndicatorsService) InflationContext(ctx context.Context) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "INFLATION", "")
+}
+
+// RetailSales retrieves monthly US advance retail sales.
+func (c *EconomicIndicatorsService) RetailSales() (*types.DataSeries, error) {
+	return c.RetailSalesContext(context.Background())
+}
+
+// RetailSalesContext is like RetailSales but uses ctx for the underlying request.
+func (c *EconomicIndicatorsService) RetailSalesContext(ctx context.Context) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "RETAIL_SALES", "")
+}
+
+// Durables retrieves monthly US manufacturers' new orders of durable goods.
+func (c *EconomicIndicatorsService) Durables() (*types.DataSeries, error) {
+	return c.DurablesContext(context.Background())
+}
+
+// DurablesContext is like Durables but uses ctx for the underlying request.
+func (c *EconomicIndicatorsService) DurablesContext(ctx context.Context) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "DURABLES", "")
+}
+
+// Unemployment retrieves the monthly US unemployment rate.
+func (c *EconomicIndicatorsService) Unemployment() (*types.DataSeries, error) {
+	return c.UnemploymentContext(context.Background())
+}
+
+// UnemploymentContext is like Unemployment but uses ctx for the underlying request.
+func (c *EconomicIndicatorsService) UnemploymentContext(ctx context.Context) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "UNEMPLOYMENT", "")
+}
+
+// NonfarmPayroll retrieves monthly US total nonfarm payroll.
+func (c *EconomicIndicatorsService) NonfarmPayroll() (*types.DataSeries, error) {
+	return c.NonfarmPayrollContext(context.Background())
+}
+
+// NonfarmPayrollContext is like NonfarmPayroll but uses ctx for the underlying request.
+func (c *EconomicIndicatorsService) NonfarmPayrollContext(ctx context.Context) (*types.DataSeries, error) {
+	return c.getSeries(ctx, "NONFARM_PAYROLL", "")
+}
+
+// getSeries validates interval against allowed and fetches the series. Endpoints
+// without an interval parameter pass an empty interval and no allowed values.
+func (c *EconomicIndicatorsService) getSeries(ctx context.Context, function string, interval types.SeriesInterval, allowed ...types.SeriesInterval) (*types.DataSeries, error) {
+	interval, err := types.CheckSeriesInterval(function, interval, allowed...)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("interval", string(interval))
+
+	return c.fetch(ctx, function, queryParams)
+}
+
+func (c *EconomicIndicatorsService) fetch(ctx context.Context, function string, queryParams url.Values) (*types.DataSeries, error) {
+	data, err := c.client.DoContext(ctx, function, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var series types.DataSeries
+	if err := types.UnmarshalLenient(data, &series); err != nil {
+		return nil, types.NewDecodeError(function, err)
+	}
+
+	return &series, nil
+}
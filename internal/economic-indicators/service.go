@@ -0,0 +1,11 @@
+package economicindicators
+
+import itypes "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/types"
+
+type EconomicIndicatorsService struct {
+	client itypes.Client
+}
+
+func NewEconomicIndicatorsService(client itypes.Client) *EconomicIndicatorsService {
+	return &EconomicIndicatorsService{client: client}
+}
@@ -1,7 +1,7 @@
 package forex
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
@@ -10,27 +10,32 @@ import (
 
 // ExchangeRate retrieves real-time exchange rates for physical currencies.
 func (c *ForexService) ExchangeRate(params types.ForexExchangeRateParams) (*types.CurrencyExchangeRateResponse, error) {
+	return c.ExchangeRateContext(context.Background(), params)
+}
+
+// ExchangeRateContext is like ExchangeRate but uses ctx for the underlying request.
+func (c *ForexService) ExchangeRateContext(ctx context.Context, params types.ForexExchangeRateParams) (*types.CurrencyExchangeRateResponse, error) {
 	from := strings.TrimSpace(params.FromCurrency)
 	to := strings.TrimSpace(params.ToCurrency)
 	if from == "" {
-		return nil, fmt.Errorf("from currency is required")
+		return nil, types.NewParameterError("CURRENCY_EXCHANGE_RATE", "from_currency", "from currency is required")
 	}
 	if to == "" {
-		return nil, fmt.Errorf("to currency is required")
+		return nil, types.NewParameterError("CURRENCY_EXCHANGE_RATE", "to_currency", "to currency is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("from_currency", from)
 	queryParams.Add("to_currency", to)
 
-	data, err := c.client.Do("CURRENCY_EXCHANGE_RATE", queryParams)
+	data, err := c.client.DoContext(ctx, "CURRENCY_EXCHANGE_RATE", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp types.CurrencyExchangeRateResponse
 	if err := types.UnmarshalLenient(data, &resp); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("CURRENCY_EXCHANGE_RATE", err)
 	}
 
 	return &resp, nil
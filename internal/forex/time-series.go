@@ -0,0 +1,115 @@
+package forex
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// Intraday retrieves intraday OHLC bars for a currency pair. This is a premium endpoint.
+func (c *ForexService) Intraday(params types.ForexIntradayParams) (*types.ForexSeriesResponse, error) {
+	return c.IntradayContext(context.Background(), params)
+}
+
+// IntradayContext is like Intraday but uses ctx for the underlying request.
+func (c *ForexService) IntradayContext(ctx context.Context, params types.ForexIntradayParams) (*types.ForexSeriesResponse, error) {
+	queryParams, err := pairParams("FX_INTRADAY", params.FromSymbol, params.ToSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := types.CheckInterval("FX_INTRADAY", params.Interval, true)
+	if err != nil {
+		return nil, err
+	}
+	outputSize, err := types.CheckOutputSize("FX_INTRADAY", params.OutputSize)
+	if err != nil {
+		return nil, err
+	}
+	queryParams.Add("interval", string(interval))
+	queryParams.Add("outputsize", string(outputSize))
+
+	return c.getSeries(ctx, "FX_INTRADAY", queryParams)
+}
+
+// Daily retrieves daily OHLC bars for a currency pair.
+func (c *ForexService) Daily(params types.ForexDailyParams) (*types.ForexSeriesResponse, error) {
+	return c.DailyContext(context.Background(), params)
+}
+
+// DailyContext is like Daily but uses ctx for the underlying request.
+func (c *ForexService) DailyContext(ctx context.Context, params types.ForexDailyParams) (*types.ForexSeriesResponse, error) {
+	queryParams, err := pairParams("FX_DAILY", params.FromSymbol, params.ToSymbol)
+	if err != nil {
+		return nil, err
+	}
+	outputSize, err := types.CheckOutputSize("FX_DAILY", params.OutputSize)
+	if err != nil {
+		return nil, err
+	}
+	queryParams.Add("outputsize", string(outputSize))
+
+	return c.getSeries(ctx, "FX_DAILY", queryParams)
+}
+
+// Weekly retrieves weekly OHLC bars for a currency pair.
+func (c *ForexService) Weekly(params types.ForexWeeklyParams) (*types.ForexSeriesResponse, error) {
+	return c.WeeklyContext(context.Background(), params)
+}
+
+// WeeklyContext is like Weekly but uses ctx for the underlying request.
+func (c *ForexService) WeeklyContext(ctx context.Context, params types.ForexWeeklyParams) (*types.ForexSeriesResponse, error) {
+	queryParams, err := pairParams("FX_WEEKLY", params.FromSymbol, params.ToSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getSeries(ctx, "FX_WEEKLY", queryParams)
+}
+
+// Monthly retrieves monthly OHLC bars for a currency pair.
+func (c *ForexService) Monthly(params types.ForexMonthlyParams) (*types.ForexSeriesResponse, error) {
+	return c.MonthlyContext(context.Background(), params)
+}
+
+// MonthlyContext is like Monthly but uses ctx for the underlying request.
+func (c *ForexService) MonthlyContext(ctx context.Context, params types.ForexMonthlyParams) (*types.ForexSeriesResponse, error) {
+	queryParams, err := pairParams("FX_MONTHLY", params.FromSymbol, params.ToSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getSeries(ctx, "FX_MONTHLY", queryParams)
+}
+
+func pairParams(function, from, to string) (url.Values, error) {
+	from = strings.TrimSpace(from)
+	to = strings.TrimSpace(to)
+	if from == "" {
+		return nil, types.NewParameterError(function, "from_symbol", "from symbol is required")
+	}
+	if to == "" {
+		return nil, types.NewParameterError(function, "to_symbol", "to symbol is required")
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("from_symbol", from)
+	queryParams.Add("to_symbol", to)
+	return queryParams, nil
+}
+
+func (c *ForexService) getSeries(ctx context.Context, function string, queryParams url.Values) (*types.ForexSeriesResponse, error) {
+	data, err := c.client.DoContext(ctx, function, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	forexData := &types.ForexSeriesResponse{}
+	if err := types.UnmarshalForexJSON(forexData, data); err != nil {
+		return nil, types.NewDecodeError(function, err)
+	}
+
+	return forexData, nil
+}
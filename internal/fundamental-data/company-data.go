@@ -1,6 +1,7 @@
 package fundamentaldata
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
@@ -10,26 +11,31 @@ import (
 
 // CompanyOverview retrieves the Alpha Vantage company overview for the given symbol.
 func (c *FundamentalDataService) CompanyOverview(symbol string) (*types.CompanyOverviewResponse, error) {
+	return c.CompanyOverviewContext(context.Background(), symbol)
+}
+
+// CompanyOverviewContext is like CompanyOverview but uses ctx for the underlying request.
+func (c *FundamentalDataService) CompanyOverviewContext(ctx context.Context, symbol string) (*types.CompanyOverviewResponse, error) {
 	symbol = strings.TrimSpace(symbol)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("OVERVIEW", "symbol", "symbol is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 
-	data, err := c.client.Do("OVERVIEW", queryParams)
+	data, err := c.client.DoContext(ctx, "OVERVIEW", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var overview types.CompanyOverviewResponse
 	if err := types.UnmarshalLenient(data, &overview); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("OVERVIEW", err)
 	}
 
 	if overview.Symbol == "" {
-		return nil, fmt.Errorf("failed to retrieve company overview for symbol %s", symbol)
+		return nil, types.NewParameterError("OVERVIEW", "symbol", fmt.Sprintf("failed to retrieve company overview for symbol %s", symbol))
 	}
 
 	return &overview, nil
@@ -37,26 +43,31 @@ func (c *FundamentalDataService) CompanyOverview(symbol string) (*types.CompanyO
 
 // IncomeStatement retrieves annual and quarterly income statements for the given symbol.
 func (c *FundamentalDataService) IncomeStatement(symbol string) (*types.IncomeStatementResponse, error) {
+	return c.IncomeStatementContext(context.Background(), symbol)
+}
+
+// IncomeStatementContext is like IncomeStatement but uses ctx for the underlying request.
+func (c *FundamentalDataService) IncomeStatementContext(ctx context.Context, symbol string) (*types.IncomeStatementResponse, error) {
 	symbol = strings.TrimSpace(symbol)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("INCOME_STATEMENT", "symbol", "symbol is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 
-	data, err := c.client.Do("INCOME_STATEMENT", queryParams)
+	data, err := c.client.DoContext(ctx, "INCOME_STATEMENT", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var statement types.IncomeStatementResponse
 	if err := types.UnmarshalLenient(data, &statement); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("INCOME_STATEMENT", err)
 	}
 
 	if statement.Symbol == "" {
-		return nil, fmt.Errorf("failed to retrieve income statement for symbol %s", symbol)
+		return nil, types.NewParameterError("INCOME_STATEMENT", "symbol", fmt.Sprintf("failed to retrieve income statement for symbol %s", symbol))
 	}
 
 	return &statement, nil
@@ -65,26 +76,31 @@ func (c *FundamentalDataService) IncomeStatement(symbol string) (*types.IncomeSt
 // BalanceSheet retrieves annual and quarterly balance sheets for the given symbol.
 // The endpoint requires function=BALANCE_SHEET and a stock symbol.
 func (c *FundamentalDataService) BalanceSheet(symbol string) (*types.BalanceSheetResponse, error) {
+	return c.BalanceSheetContext(context.Background(), symbol)
+}
+
+// BalanceSheetContext is like BalanceSheet but uses ctx for the underlying request.
+func (c *FundamentalDataService) BalanceSheetContext(ctx context.Context, symbol string) (*types.BalanceSheetResponse, error) {
 	symbol = strings.TrimSpace(symbol)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("BALANCE_SHEET", "symbol", "symbol is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 
-	data, err := c.client.Do("BALANCE_SHEET", queryParams)
+	data, err := c.client.DoContext(ctx, "BALANCE_SHEET", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var sheet types.BalanceSheetResponse
 	if err := types.UnmarshalLenient(data, &sheet); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("BALANCE_SHEET", err)
 	}
 
 	if sheet.Symbol == "" {
-		return nil, fmt.Errorf("failed to retrieve balance sheet for symbol %s", symbol)
+		return nil, types.NewParameterError("BALANCE_SHEET", "symbol", fmt.Sprintf("failed to retrieve balance sheet for symbol %s", symbol))
 	}
 
 	return &sheet, nil
@@ -93,26 +109,31 @@ func (c *FundamentalDataService) BalanceSheet(symbol string) (*types.BalanceShee
 // CashFlow retrieves annual and quarterly cash flow statements for the given symbol.
 // The endpoint requires function=CASH_FLOW and a stock symbol.
 func (c *FundamentalDataService) CashFlow(symbol string) (*types.CashFlowResponse, error) {
+	return c.CashFlowContext(context.Background(), symbol)
+}
+
+// CashFlowContext is like CashFlow but uses ctx for the underlying request.
+func (c *FundamentalDataService) CashFlowContext(ctx context.Context, symbol string) (*types.CashFlowResponse, error) {
 	symbol = strings.TrimSpace(symbol)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("CASH_FLOW", "symbol", "symbol is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 
-	data, err := c.client.Do("CASH_FLOW", queryParams)
+	data, err := c.client.DoContext(ctx, "CASH_FLOW", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var cashFlow types.CashFlowResponse
 	if err := types.UnmarshalLenient(data, &cashFlow); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("CASH_FLOW", err)
 	}
 
 	if cashFlow.Symbol == "" {
-		return nil, fmt.Errorf("failed to retrieve cash flow for symbol %s", symbol)
+		return nil, types.NewParameterError("CASH_FLOW", "symbol", fmt.Sprintf("failed to retrieve cash flow for symbol %s", symbol))
 	}
 
 	return &cashFlow, nil
@@ -1,7 +1,7 @@
 package fundamentaldata
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
@@ -10,22 +10,27 @@ import (
 
 // Dividends retrieves historical and declared dividends for a symbol.
 func (c *FundamentalDataService) Dividends(symbol string) (*types.DividendsResponse, error) {
+	return c.DividendsContext(context.Background(), symbol)
+}
+
+// DividendsContext is like Dividends but uses ctx for the underlying request.
+func (c *FundamentalDataService) DividendsContext(ctx context.Context, symbol string) (*types.DividendsResponse, error) {
 	symbol = strings.TrimSpace(symbol)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("DIVIDENDS", "symbol", "symbol is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 
-	data, err := c.client.Do("DIVIDENDS", queryParams)
+	data, err := c.client.DoContext(ctx, "DIVIDENDS", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var divs types.DividendsResponse
 	if err := types.UnmarshalLenient(data, &divs); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("DIVIDENDS", err)
 	}
 
 	return &divs, nil
@@ -33,22 +38,27 @@ func (c *FundamentalDataService) Dividends(symbol string) (*types.DividendsRespo
 
 // Splits retrieves historical split events for a symbol.
 func (c *FundamentalDataService) Splits(symbol string) (*types.SplitsResponse, error) {
+	return c.SplitsContext(context.Background(), symbol)
+}
+
+// SplitsContext is like Splits but uses ctx for the underlying request.
+func (c *FundamentalDataService) SplitsContext(ctx context.Context, symbol string) (*types.SplitsResponse, error) {
 	symbol = strings.TrimSpace(symbol)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("SPLITS", "symbol", "symbol is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 
-	data, err := c.client.Do("SPLITS", queryParams)
+	data, err := c.client.DoContext(ctx, "SPLITS", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var splits types.SplitsResponse
 	if err := types.UnmarshalLenient(data, &splits); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("SPLITS", err)
 	}
 
 	return &splits, nil
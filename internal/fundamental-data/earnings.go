@@ -0,0 +1,66 @@
+package fundamentaldata
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// Earnings retrieves annual and quarterly EPS for a symbol, with analyst
+// estimates and surprise metrics for each quarter.
+func (c *FundamentalDataService) Earnings(symbol string) (*types.EarningsResponse, error) {
+	return c.EarningsContext(context.Background(), symbol)
+}
+
+// EarningsContext is like Earnings but uses ctx for the underlying request.
+func (c *FundamentalDataService) EarningsContext(ctx context.Context, symbol string) (*types.EarningsResponse, error) {
+	symbol = strings.TrimSpace(symbol)
+	if symbol == "" {
+		return nil, types.NewParameterError("EARNINGS", "symbol", "symbol is required")
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("symbol", symbol)
+
+	data, err := c.client.DoContext(ctx, "EARNINGS", queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var earnings types.EarningsResponse
+	if err := types.UnmarshalLenient(data, &earnings); err != nil {
+		return nil, types.NewDecodeError("EARNINGS", err)
+	}
+
+	return &earnings, nil
+}
+
+// EarningsEstimates retrieves analyst EPS and revenue estimates for a symbol.
+func (c *FundamentalDataService) EarningsEstimates(symbol string) (*types.EarningsEstimatesResponse, error) {
+	return c.EarningsEstimatesContext(context.Background(), symbol)
+}
+
+// EarningsEstimatesContext is like EarningsEstimates but uses ctx for the underlying request.
+func (c *FundamentalDataService) EarningsEstimatesContext(ctx context.Context, symbol string) (*types.EarningsEstimatesResponse, error) {
+	symbol = strings.TrimSpace(symbol)
+	if symbol == "" {
+		return nil, types.NewParameterError("EARNINGS_ESTIMATES", "symbol", "symbol is required")
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("symbol", symbol)
+
+	data, err := c.client.DoContext(ctx, "EARNINGS_ESTIMATES", queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var estimates types.EarningsEstimatesResponse
+	if err := types.UnmarshalLenient(data, &estimates); err != nil {
+		return nil, types.NewDecodeError("EARNINGS_ESTIMATES", err)
+	}
+
+	return &estimates, nil
+}
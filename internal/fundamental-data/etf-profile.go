@@ -1,7 +1,7 @@
 package fundamentaldata
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
@@ -10,22 +10,27 @@ import (
 
 // ETFProfile retrieves ETF profile and holdings for the given symbol.
 func (c *FundamentalDataService) ETFProfile(symbol string) (*types.ETFProfile, error) {
+	return c.ETFProfileContext(context.Background(), symbol)
+}
+
+// ETFProfileContext is like ETFProfile but uses ctx for the underlying request.
+func (c *FundamentalDataService) ETFProfileContext(ctx context.Context, symbol string) (*types.ETFProfile, error) {
 	symbol = strings.TrimSpace(symbol)
 	if symbol == "" {
-		return nil, fmt.Errorf("symbol is required")
+		return nil, types.NewParameterError("ETF_PROFILE", "symbol", "symbol is required")
 	}
 
 	queryParams := url.Values{}
 	queryParams.Add("symbol", symbol)
 
-	data, err := c.client.Do("ETF_PROFILE", queryParams)
+	data, err := c.client.DoContext(ctx, "ETF_PROFILE", queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var profile types.ETFProfile
 	if err := types.UnmarshalLenient(data, &profile); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError("ETF_PROFILE", err)
 	}
 
 	return &profile, nil
@@ -0,0 +1,103 @@
+package fundamentaldata
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// earliestListingDate is the first date LISTING_STATUS has data for.
+var earliestListingDate = time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// ListingStatus retrieves active or delisted US stocks and ETFs, either as of
+// the latest trading day or as of params.Date.
+func (c *FundamentalDataService) ListingStatus(params types.ListingStatusParams) (*types.ListingStatusResponse, error) {
+	return c.ListingStatusContext(context.Background(), params)
+}
+
+// ListingStatusContext is like ListingStatus but uses ctx for the underlying request.
+func (c *FundamentalDataService) ListingStatusContext(ctx context.Context, params types.ListingStatusParams) (*types.ListingStatusResponse, error) {
+	queryParams := url.Values{}
+	if !params.Date.IsZero() {
+		date := time.Date(params.Date.Year(), params.Date.Month(), params.Date.Day(), 0, 0, 0, 0, time.UTC)
+		if date.Before(earliestListingDate) {
+			return nil, types.NewParameterError("LISTING_STATUS", "date", "date must be on or after 2010-01-01")
+		}
+		queryParams.Add("date", date.Format("2006-01-02"))
+	}
+
+	switch state := types.ListingState(strings.ToLower(strings.TrimSpace(string(params.State)))); state {
+	case "":
+	case types.ListingActive, types.ListingDelisted:
+		queryParams.Add("state", string(state))
+	default:
+		return nil, types.NewParameterError("LISTING_STATUS", "state", "state must be active or delisted")
+	}
+
+	data, err := c.client.DoCSVContext(ctx, "LISTING_STATUS", queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	listings, err := types.ParseListingStatusCSV(data)
+	if err != nil {
+		return nil, types.NewDecodeError("LISTING_STATUS", err)
+	}
+
+	return listings, nil
+}
+
+// EarningsCalendar retrieves expected earnings reports over the next 3, 6 or
+// 12 months, for one symbol or for all companies.
+func (c *FundamentalDataService) EarningsCalendar(params types.EarningsCalendarParams) (*types.EarningsCalendarResponse, error) {
+	return c.EarningsCalendarContext(context.Background(), params)
+}
+
+// EarningsCalendarContext is like EarningsCalendar but uses ctx for the underlying request.
+func (c *FundamentalDataService) EarningsCalendarContext(ctx context.Context, params types.EarningsCalendarParams) (*types.EarningsCalendarResponse, error) {
+	queryParams := url.Values{}
+	queryParams.Add("symbol", strings.TrimSpace(params.Symbol))
+
+	switch horizon := types.EarningsHorizon(strings.ToLower(strings.TrimSpace(string(params.Horizon)))); horizon {
+	case "":
+	case types.Horizon3Month, types.Horizon6Month, types.Horizon12Month:
+		queryParams.Add("horizon", string(horizon))
+	default:
+		return nil, types.NewParameterError("EARNINGS_CALENDAR", "horizon", "horizon must be one of 3month, 6month, 12month")
+	}
+
+	data, err := c.client.DoCSVContext(ctx, "EARNINGS_CALENDAR", queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := types.ParseEarningsCalendarCSV(data)
+	if err != nil {
+		return nil, types.NewDecodeError("EARNINGS_CALENDAR", err)
+	}
+
+	return calendar, nil
+}
+
+// IPOCalendar retrieves IPOs expected in the next three months.
+func (c *FundamentalDataService) IPOCalendar() (*types.IPOCalendarResponse, error) {
+	return c.IPOCalendarContext(context.Background())
+}
+
+// IPOCalendarContext is like IPOCalendar but uses ctx for the underlying request.
+func (c *FundamentalDataService) IPOCalendarContext(ctx context.Context) (*types.IPOCalendarResponse, error) {
+	data, err := c.client.DoCSVContext(ctx, "IPO_CALENDAR", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := types.ParseIPOCalendarCSV(data)
+	if err != nil {
+		return nil, types.NewDecodeError("IPO_CALENDAR", err)
+	}
+
+	return calendar, nil
+}
@@ -0,0 +1,70 @@
+package optionsdata
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// RealtimeOptions retrieves the realtime US options chain for a symbol, or a
+// single contract when params.Contract is set. This is a premium endpoint.
+func (c *OptionsDataService) RealtimeOptions(params types.RealtimeOptionsParams) (*types.OptionsChainResponse, error) {
+	return c.RealtimeOptionsContext(context.Background(), params)
+}
+
+// RealtimeOptionsContext is like RealtimeOptions but uses ctx for the underlying request.
+func (c *OptionsDataService) RealtimeOptionsContext(ctx context.Context, params types.RealtimeOptionsParams) (*types.OptionsChainResponse, error) {
+	symbol := strings.TrimSpace(params.Symbol)
+	if symbol == "" {
+		return nil, types.NewParameterError("REALTIME_OPTIONS", "symbol", "symbol is required")
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("symbol", symbol)
+	if contract := strings.TrimSpace(params.Contract); contract != "" {
+		queryParams.Add("contract", contract)
+	}
+	if params.RequireGreeks {
+		queryParams.Add("require_greeks", "true")
+	}
+
+	return c.getChain(ctx, "REALTIME_OPTIONS", queryParams)
+}
+
+// HistoricalOptions retrieves the full options chain for a symbol on a given
+// trading day, including implied volatility and greeks.
+func (c *OptionsDataService) HistoricalOptions(params types.HistoricalOptionsParams) (*types.OptionsChainResponse, error) {
+	return c.HistoricalOptionsContext(context.Background(), params)
+}
+
+// HistoricalOptionsContext is like HistoricalOptions but uses ctx for the underlying request.
+func (c *OptionsDataService) HistoricalOptionsContext(ctx context.Context, params types.HistoricalOptionsParams) (*types.OptionsChainResponse, error) {
+	symbol := strings.TrimSpace(params.Symbol)
+	if symbol == "" {
+		return nil, types.NewParameterError("HISTORICAL_OPTIONS", "symbol", "symbol is required")
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("symbol", symbol)
+	if !params.Date.IsZero() {
+		queryParams.Add("date", params.Date.Format("2006-01-02"))
+	}
+
+	return c.getChain(ctx, "HISTORICAL_OPTIONS", queryParams)
+}
+
+func (c *OptionsDataService) getChain(ctx context.Context, function string, queryParams url.Values) (*types.OptionsChainResponse, error) {
+	data, err := c.client.DoContext(ctx, function, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var chain types.OptionsChainResponse
+	if err := types.UnmarshalLenient(data, &chain); err != nil {
+		return nil, types.NewDecodeError(function, err)
+	}
+
+	return &chain, nil
+}
@@ -0,0 +1,11 @@
+package optionsdata
+
+import itypes "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/types"
+
+type OptionsDataService struct {
+	client itypes.Client
+}
+
+func NewOptionsDataService(client itypes.Client) *OptionsDataService {
+	return &OptionsDataService{client: client}
+}
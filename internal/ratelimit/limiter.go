@@ -0,0 +1,141 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// Limiter is a token-bucket rate limiter enforcing per-minute and per-day quotas.
+// A single Limiter is safe for concurrent use by multiple goroutines.
+type Limiter struct {
+	mu     sync.Mutex
+	policy types.RateLimitPolicy
+	minute *bucket
+	day    *bucket
+	now    func() time.Time
+}
+
+// bucket holds up to capacity tokens and refills continuously over period.
+type bucket struct {
+	capacity float64
+	tokens   float64
+	rate     float64 // tokens per nanosecond
+	last     time.Time
+}
+
+// New returns a Limiter for cfg, or nil when cfg has no quotas configured.
+func New(cfg types.RateLimit) *Limiter {
+	return newLimiter(cfg, time.Now)
+}
+
+func newLimiter(cfg types.RateLimit, now func() time.Time) *Limiter {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	start := now()
+	l := &Limiter{policy: cfg.Policy, now: now}
+	if cfg.RequestsPerMinute > 0 {
+		l.minute = newBucket(cfg.RequestsPerMinute, time.Minute, start)
+	}
+	if cfg.RequestsPerDay > 0 {
+		l.day = newBucket(cfg.RequestsPerDay, 24*time.Hour, start)
+	}
+	return l
+}
+
+func newBucket(limit int, period time.Duration, start time.Time) *bucket {
+	return &bucket{
+		capacity: float64(limit),
+		tokens:   float64(limit),
+		rate:     float64(limit) / float64(period),
+		last:     start,
+	}
+}
+
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += float64(elapsed) * b.rate
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+		b.last = now
+	}
+}
+
+// delay returns how long until the bucket holds a whole token.
+func (b *bucket) delay() time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+	if d := time.Duration((1 - b.tokens) / b.rate); d > 0 {
+		return d
+	}
+	return time.Nanosecond
+}
+
+// Wait consumes one token from every configured bucket. Under RateLimitBlock it
+// waits until tokens are available or ctx is done; under RateLimitFailFast it
+// returns an error wrapping types.ErrRateLimitExceeded without waiting.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	for {
+		wait := l.reserve()
+		if wait == 0 {
+			return nil
+		}
+
+		if l.policy == types.RateLimitFailFast {
+			return fmt.Errorf("%w: next request available in %s", types.ErrRateLimitExceeded, wait.Round(time.Millisecond))
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && deadline.Sub(l.now()) < wait {
+			return fmt.Errorf("%w: next request available in %s, after context deadline", types.ErrRateLimitExceeded, wait.Round(time.Millisecond))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token from each bucket if all of them have one available and
+// returns zero; otherwise it takes nothing and returns the time to wait.
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	var wait time.Duration
+	for _, b := range []*bucket{l.minute, l.day} {
+		if b == nil {
+			continue
+		}
+		b.refill(now)
+		if d := b.delay(); d > wait {
+			wait = d
+		}
+	}
+
+	if wait > 0 {
+		return wait
+	}
+
+	for _, b := range []*bucket{l.minute, l.day} {
+		if b != nil {
+			b.tokens--
+		}
+	}
+	return 0
+}
@@ -0,0 +1,142 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestNew_ReturnsNilWhenDisabled(t *testing.T) {
+	if l := New(types.RateLimit{}); l != nil {
+		t.Fatalf("expected nil limiter for zero config")
+	}
+
+	var l *Limiter
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("nil limiter should never block, got %v", err)
+	}
+}
+
+func TestLimiter_FailFastExhaustsMinuteQuotaAndRefills(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := newLimiter(types.RateLimit{RequestsPerMinute: 5, Policy: types.RateLimitFailFast}, clock.Now)
+
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("request %d: unexpected error %v", i+1, err)
+		}
+	}
+
+	err := l.Wait(context.Background())
+	if !errors.Is(err, types.ErrRateLimitExceeded) {
+		t.Fatalf("expected ErrRateLimitExceeded, got %v", err)
+	}
+
+	clock.Advance(12 * time.Second)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("expected a token after 12s refill, got %v", err)
+	}
+}
+
+func TestLimiter_DailyQuotaAppliesAcrossMinutes(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := newLimiter(types.RateLimit{RequestsPerMinute: 5, RequestsPerDay: 6, Policy: types.RateLimitFailFast}, clock.Now)
+
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("request %d: unexpected error %v", i+1, err)
+		}
+	}
+	clock.Advance(time.Minute)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("request 6: unexpected error %v", err)
+	}
+
+	clock.Advance(time.Minute)
+	if err := l.Wait(context.Background()); !errors.Is(err, types.ErrRateLimitExceeded) {
+		t.Fatalf("expected daily quota to be exhausted, got %v", err)
+	}
+}
+
+func TestLimiter_BlockHonorsContextCancellation(t *testing.T) {
+	l := New(types.RateLimit{RequestsPerMinute: 1})
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestLimiter_BlockFailsWhenDeadlineTooSoon(t *testing.T) {
+	l := New(types.RateLimit{RequestsPerMinute: 1})
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	if err := l.Wait(ctx); !errors.Is(err, types.ErrRateLimitExceeded) {
+		t.Fatalf("expected ErrRateLimitExceeded, got %v", err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Fatalf("expected Wait to return without sleeping until the deadline")
+	}
+}
+
+func TestLimiter_ConcurrentCallersShareQuota(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := newLimiter(types.RateLimit{RequestsPerMinute: 10, Policy: types.RateLimitFailFast}, clock.Now)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		granted int
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if l.Wait(context.Background()) == nil {
+				mu.Lock()
+				granted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if granted != 10 {
+		t.Fatalf("expected exactly 10 requests granted, got %d", granted)
+	}
+}
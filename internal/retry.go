@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// retryClass maps err to the RetryClass it belongs to, or 0 if it is never retryable.
+func retryClass(err error) types.RetryClass {
+	var apiErr *types.APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.Kind == types.ErrRateLimited {
+			return types.RetryThrottle
+		}
+		return 0
+	}
+
+	var statusErr *types.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		if statusErr.StatusCode >= 500 || statusErr.StatusCode == http.StatusTooManyRequests {
+			return types.RetryServerError
+		}
+		return 0
+	}
+
+	if errors.Is(err, types.ErrRateLimitExceeded) || errors.Is(err, context.Canceled) {
+		return 0
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return types.RetryTimeout
+	}
+
+	if netErr != nil {
+		return types.RetryNetwork
+	}
+
+	return 0
+}
+
+// shouldRetry reports whether another attempt is allowed after attempt failed with err.
+func shouldRetry(ctx context.Context, policy types.RetryPolicy, attempt int, err error) bool {
+	if attempt >= policy.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	class := retryClass(err)
+	return class != 0 && policy.Retries(class)
+}
+
+// backoff returns the delay before the retry that follows attempt (1-based).
+func backoff(policy types.RetryPolicy, attempt int) time.Duration {
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	delay := float64(policy.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if policy.MaxBackoff > 0 && delay > float64(policy.MaxBackoff) {
+		delay = float64(policy.MaxBackoff)
+	}
+
+	if jitter := math.Min(math.Max(policy.Jitter, 0), 1); jitter > 0 {
+		delay -= delay * jitter * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,79 @@
+package technicalindicators
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
+)
+
+// timePeriodFunctions lists the indicators that require time_period.
+var timePeriodFunctions = map[string]bool{
+	"SMA": true, "EMA": true, "WMA": true, "DEMA": true, "TEMA": true, "TRIMA": true,
+	"KAMA": true, "T3": true, "RSI": true, "STOCHRSI": true, "WILLR": true, "ADX": true,
+	"ADXR": true, "MOM": true, "CCI": true, "CMO": true, "ROC": true, "ROCR": true,
+	"AROON": true, "AROONOSC": true, "MFI": true, "TRIX": true, "DX": true,
+	"MINUS_DI": true, "PLUS_DI": true, "MINUS_DM": true, "PLUS_DM": true,
+	"BBANDS": true, "MIDPOINT": true, "MIDPRICE": true, "ATR": true, "NATR": true,
+}
+
+// seriesTypeFunctions lists the indicators that require series_type.
+var seriesTypeFunctions = map[string]bool{
+	"SMA": true, "EMA": true, "WMA": true, "DEMA": true, "TEMA": true, "TRIMA": true,
+	"KAMA": true, "MAMA": true, "T3": true, "MACD": true, "MACDEXT": true, "RSI": true,
+	"STOCHRSI": true, "APO": true, "PPO": true, "MOM": true, "CMO": true, "ROC": true,
+	"ROCR": true, "TRIX": true, "BBANDS": true, "MIDPOINT": true,
+	"HT_TRENDLINE": true, "HT_SINE": true, "HT_TRENDMODE": true, "HT_DCPERIOD": true,
+	"HT_DCPHASE": true, "HT_PHASOR": true,
+}
+
+// intradayFunctions lists the indicators that only accept minute intervals.
+var intradayFunctions = map[string]bool{"VWAP": true}
+
+// indicatorOptions collects the function specific query parameters of an
+// indicator, keeping the first validation error.
+type indicatorOptions struct {
+	function string
+	values   url.Values
+	err      error
+}
+
+func newIndicatorOptions(function string) *indicatorOptions {
+	return &indicatorOptions{function: function, values: url.Values{}}
+}
+
+// period adds a positive integer option. Zero leaves the API default.
+func (o *indicatorOptions) period(name string, v int) {
+	switch {
+	case v < 0:
+		o.fail(name, name+" must not be negative")
+	case v > 0:
+		o.values.Add(name, strconv.Itoa(v))
+	}
+}
+
+// number adds a positive float option. Zero leaves the API default.
+func (o *indicatorOptions) number(name string, v float64) {
+	switch {
+	case v < 0:
+		o.fail(name, name+" must not be negative")
+	case v > 0:
+		o.values.Add(name, strconv.FormatFloat(v, 'f', -1, 64))
+	}
+}
+
+// maType adds a moving average type option. MATypeSMA is the API default.
+func (o *indicatorOptions) maType(name string, v types.MAType) {
+	switch {
+	case !v.Valid():
+		o.fail(name, name+" must be between 0 and 8")
+	case v != types.MATypeSMA:
+		o.values.Add(name, strconv.Itoa(int(v)))
+	}
+}
+
+func (o *indicatorOptions) fail(name, message string) {
+	if o.err == nil {
+		o.err = types.NewParameterError(o.function, name, message)
+	}
+}
@@ -1,8 +1,10 @@
 package technicalindicators
 
 import (
-	"fmt"
+	"context"
 	"net/url"
+	"strconv"
+	"strings"
 
 	itypes "github.com/BeardedWonderDev/alpha-vantage-sdk-go/internal/types"
 	"github.com/BeardedWonderDev/alpha-vantage-sdk-go/types"
@@ -18,345 +20,693 @@ func NewTechnicalIndicatorsService(client itypes.Client) *TechnicalIndicatorsSer
 
 // SMA retrieves SMA data based on the provided parameters.
 func (c *TechnicalIndicatorsService) SMA(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.SMAContext(context.Background(), params)
+}
+
+// SMAContext is like SMA but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) SMAContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "SMA"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // EMA retrieves EMA data based on the provided parameters.
 func (c *TechnicalIndicatorsService) EMA(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.EMAContext(context.Background(), params)
+}
+
+// EMAContext is like EMA but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) EMAContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "EMA"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // WMA retrieves WMA data based on the provided parameters.
 func (c *TechnicalIndicatorsService) WMA(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.WMAContext(context.Background(), params)
+}
+
+// WMAContext is like WMA but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) WMAContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "WMA"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // DEMA retrieves DEMA data based on the provided parameters.
 func (c *TechnicalIndicatorsService) DEMA(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.DEMAContext(context.Background(), params)
+}
+
+// DEMAContext is like DEMA but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) DEMAContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "DEMA"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // TEMA retrieves TEMA data based on the provided parameters.
 func (c *TechnicalIndicatorsService) TEMA(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.TEMAContext(context.Background(), params)
+}
+
+// TEMAContext is like TEMA but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) TEMAContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "TEMA"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // TRIMA retrieves TRIMA data based on the provided parameters.
 func (c *TechnicalIndicatorsService) TRIMA(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.TRIMAContext(context.Background(), params)
+}
+
+// TRIMAContext is like TRIMA but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) TRIMAContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "TRIMA"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // KAMA retrieves KAMA data based on the provided parameters.
 func (c *TechnicalIndicatorsService) KAMA(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.KAMAContext(context.Background(), params)
+}
+
+// KAMAContext is like KAMA but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) KAMAContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "KAMA"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // MAMA retrieves MAMA data based on the provided parameters.
-func (c *TechnicalIndicatorsService) MAMA(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) MAMA(params types.MAMAParams) (*types.IndicatorResponse, error) {
+	return c.MAMAContext(context.Background(), params)
+}
+
+// MAMAContext is like MAMA but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) MAMAContext(ctx context.Context, params types.MAMAParams) (*types.IndicatorResponse, error) {
 	params.Function = "MAMA"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.number("fastlimit", params.FastLimit)
+	options.number("slowlimit", params.SlowLimit)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // VWAP retrieves VWAP data based on the provided parameters.
 func (c *TechnicalIndicatorsService) VWAP(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.VWAPContext(context.Background(), params)
+}
+
+// VWAPContext is like VWAP but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) VWAPContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "VWAP"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // T3 retrieves T3 data based on the provided parameters.
 func (c *TechnicalIndicatorsService) T3(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.T3Context(context.Background(), params)
+}
+
+// T3Context is like T3 but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) T3Context(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "T3"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // MACD retrieves MACD data based on the provided parameters.
-func (c *TechnicalIndicatorsService) MACD(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) MACD(params types.MACDParams) (*types.IndicatorResponse, error) {
+	return c.MACDContext(context.Background(), params)
+}
+
+// MACDContext is like MACD but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) MACDContext(ctx context.Context, params types.MACDParams) (*types.IndicatorResponse, error) {
 	params.Function = "MACD"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.period("fastperiod", params.FastPeriod)
+	options.period("slowperiod", params.SlowPeriod)
+	options.period("signalperiod", params.SignalPeriod)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // MACDEXT retrieves MACDEXT data based on the provided parameters.
-func (c *TechnicalIndicatorsService) MACDEXT(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) MACDEXT(params types.MACDEXTParams) (*types.IndicatorResponse, error) {
+	return c.MACDEXTContext(context.Background(), params)
+}
+
+// MACDEXTContext is like MACDEXT but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) MACDEXTContext(ctx context.Context, params types.MACDEXTParams) (*types.IndicatorResponse, error) {
 	params.Function = "MACDEXT"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.period("fastperiod", params.FastPeriod)
+	options.period("slowperiod", params.SlowPeriod)
+	options.period("signalperiod", params.SignalPeriod)
+	options.maType("fastmatype", params.FastMAType)
+	options.maType("slowmatype", params.SlowMAType)
+	options.maType("signalmatype", params.SignalMAType)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // STOCH retrieves STOCH data based on the provided parameters.
-func (c *TechnicalIndicatorsService) STOCH(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) STOCH(params types.STOCHParams) (*types.IndicatorResponse, error) {
+	return c.STOCHContext(context.Background(), params)
+}
+
+// STOCHContext is like STOCH but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) STOCHContext(ctx context.Context, params types.STOCHParams) (*types.IndicatorResponse, error) {
 	params.Function = "STOCH"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.period("fastkperiod", params.FastKPeriod)
+	options.period("slowkperiod", params.SlowKPeriod)
+	options.period("slowdperiod", params.SlowDPeriod)
+	options.maType("slowkmatype", params.SlowKMAType)
+	options.maType("slowdmatype", params.SlowDMAType)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // STOCHF retrieves STOCHF data based on the provided parameters.
-func (c *TechnicalIndicatorsService) STOCHF(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) STOCHF(params types.STOCHFParams) (*types.IndicatorResponse, error) {
+	return c.STOCHFContext(context.Background(), params)
+}
+
+// STOCHFContext is like STOCHF but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) STOCHFContext(ctx context.Context, params types.STOCHFParams) (*types.IndicatorResponse, error) {
 	params.Function = "STOCHF"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.period("fastkperiod", params.FastKPeriod)
+	options.period("fastdperiod", params.FastDPeriod)
+	options.maType("fastdmatype", params.FastDMAType)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // RSI retrieves RSI data based on the provided parameters.
 func (c *TechnicalIndicatorsService) RSI(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.RSIContext(context.Background(), params)
+}
+
+// RSIContext is like RSI but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) RSIContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "RSI"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // STOCHRSI retrieves STOCHRSI data based on the provided parameters.
-func (c *TechnicalIndicatorsService) STOCHRSI(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) STOCHRSI(params types.STOCHRSIParams) (*types.IndicatorResponse, error) {
+	return c.STOCHRSIContext(context.Background(), params)
+}
+
+// STOCHRSIContext is like STOCHRSI but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) STOCHRSIContext(ctx context.Context, params types.STOCHRSIParams) (*types.IndicatorResponse, error) {
 	params.Function = "STOCHRSI"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.period("fastkperiod", params.FastKPeriod)
+	options.period("fastdperiod", params.FastDPeriod)
+	options.maType("fastdmatype", params.FastDMAType)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // WILLR retrieves WILLR data based on the provided parameters.
 func (c *TechnicalIndicatorsService) WILLR(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.WILLRContext(context.Background(), params)
+}
+
+// WILLRContext is like WILLR but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) WILLRContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "WILLR"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // ADX retrieves ADX data based on the provided parameters.
 func (c *TechnicalIndicatorsService) ADX(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.ADXContext(context.Background(), params)
+}
+
+// ADXContext is like ADX but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) ADXContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "ADX"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // ADXR retrieves ADXR data based on the provided parameters.
 func (c *TechnicalIndicatorsService) ADXR(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.ADXRContext(context.Background(), params)
+}
+
+// ADXRContext is like ADXR but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) ADXRContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "ADXR"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // APO retrieves APO data based on the provided parameters.
-func (c *TechnicalIndicatorsService) APO(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) APO(params types.APOParams) (*types.IndicatorResponse, error) {
+	return c.APOContext(context.Background(), params)
+}
+
+// APOContext is like APO but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) APOContext(ctx context.Context, params types.APOParams) (*types.IndicatorResponse, error) {
 	params.Function = "APO"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.period("fastperiod", params.FastPeriod)
+	options.period("slowperiod", params.SlowPeriod)
+	options.maType("matype", params.MAType)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // PPO retrieves PPO data based on the provided parameters.
-func (c *TechnicalIndicatorsService) PPO(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) PPO(params types.PPOParams) (*types.IndicatorResponse, error) {
+	return c.PPOContext(context.Background(), params)
+}
+
+// PPOContext is like PPO but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) PPOContext(ctx context.Context, params types.PPOParams) (*types.IndicatorResponse, error) {
 	params.Function = "PPO"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.period("fastperiod", params.FastPeriod)
+	options.period("slowperiod", params.SlowPeriod)
+	options.maType("matype", params.MAType)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // MOM retrieves MOM data based on the provided parameters.
 func (c *TechnicalIndicatorsService) MOM(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.MOMContext(context.Background(), params)
+}
+
+// MOMContext is like MOM but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) MOMContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "MOM"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // BOP retrieves BOP data based on the provided parameters.
 func (c *TechnicalIndicatorsService) BOP(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.BOPContext(context.Background(), params)
+}
+
+// BOPContext is like BOP but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) BOPContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "BOP"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // CCI retrieves CCI data based on the provided parameters.
 func (c *TechnicalIndicatorsService) CCI(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.CCIContext(context.Background(), params)
+}
+
+// CCIContext is like CCI but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) CCIContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "CCI"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // CMO retrieves CMO data based on the provided parameters.
 func (c *TechnicalIndicatorsService) CMO(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.CMOContext(context.Background(), params)
+}
+
+// CMOContext is like CMO but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) CMOContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "CMO"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // ROC retrieves ROC data based on the provided parameters.
 func (c *TechnicalIndicatorsService) ROC(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.ROCContext(context.Background(), params)
+}
+
+// ROCContext is like ROC but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) ROCContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "ROC"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // ROCR retrieves ROCR data based on the provided parameters.
 func (c *TechnicalIndicatorsService) ROCR(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.ROCRContext(context.Background(), params)
+}
+
+// ROCRContext is like ROCR but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) ROCRContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "ROCR"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // AROON retrieves AROON data based on the provided parameters.
 func (c *TechnicalIndicatorsService) AROON(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.AROONContext(context.Background(), params)
+}
+
+// AROONContext is like AROON but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) AROONContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "AROON"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // AROONOSC retrieves AROONOSC data based on the provided parameters.
 func (c *TechnicalIndicatorsService) AROONOSC(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.AROONOSCContext(context.Background(), params)
+}
+
+// AROONOSCContext is like AROONOSC but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) AROONOSCContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "AROONOSC"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // MFI retrieves MFI data based on the provided parameters.
 func (c *TechnicalIndicatorsService) MFI(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.MFIContext(context.Background(), params)
+}
+
+// MFIContext is like MFI but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) MFIContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "MFI"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // TRIX retrieves TRIX data based on the provided parameters.
 func (c *TechnicalIndicatorsService) TRIX(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.TRIXContext(context.Background(), params)
+}
+
+// TRIXContext is like TRIX but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) TRIXContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "TRIX"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // ULTOSC retrieves ULTOSC data based on the provided parameters.
-func (c *TechnicalIndicatorsService) ULTOSC(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) ULTOSC(params types.ULTOSCParams) (*types.IndicatorResponse, error) {
+	return c.ULTOSCContext(context.Background(), params)
+}
+
+// ULTOSCContext is like ULTOSC but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) ULTOSCContext(ctx context.Context, params types.ULTOSCParams) (*types.IndicatorResponse, error) {
 	params.Function = "ULTOSC"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.period("timeperiod1", params.TimePeriod1)
+	options.period("timeperiod2", params.TimePeriod2)
+	options.period("timeperiod3", params.TimePeriod3)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // DX retrieves DX data based on the provided parameters.
 func (c *TechnicalIndicatorsService) DX(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.DXContext(context.Background(), params)
+}
+
+// DXContext is like DX but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) DXContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "DX"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // MINUSDI retrieves MINUSDI data based on the provided parameters.
 func (c *TechnicalIndicatorsService) MINUSDI(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.MINUSDIContext(context.Background(), params)
+}
+
+// MINUSDIContext is like MINUSDI but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) MINUSDIContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "MINUS_DI"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // PLUSDI retrieves PLUSDI data based on the provided parameters.
 func (c *TechnicalIndicatorsService) PLUSDI(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.PLUSDIContext(context.Background(), params)
+}
+
+// PLUSDIContext is like PLUSDI but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) PLUSDIContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "PLUS_DI"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // MINUSDM retrieves MINUSDM data based on the provided parameters.
 func (c *TechnicalIndicatorsService) MINUSDM(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.MINUSDMContext(context.Background(), params)
+}
+
+// MINUSDMContext is like MINUSDM but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) MINUSDMContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "MINUS_DM"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // PLUSDM retrieves PLUSDM data based on the provided parameters.
 func (c *TechnicalIndicatorsService) PLUSDM(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.PLUSDMContext(context.Background(), params)
+}
+
+// PLUSDMContext is like PLUSDM but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) PLUSDMContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "PLUS_DM"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // BBANDS retrieves BBANDS data based on the provided parameters.
-func (c *TechnicalIndicatorsService) BBANDS(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) BBANDS(params types.BBANDSParams) (*types.IndicatorResponse, error) {
+	return c.BBANDSContext(context.Background(), params)
+}
+
+// BBANDSContext is like BBANDS but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) BBANDSContext(ctx context.Context, params types.BBANDSParams) (*types.IndicatorResponse, error) {
 	params.Function = "BBANDS"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.period("nbdevup", params.NbDevUp)
+	options.period("nbdevdn", params.NbDevDn)
+	options.maType("matype", params.MAType)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // MIDPOINT retrieves MIDPOINT data based on the provided parameters.
 func (c *TechnicalIndicatorsService) MIDPOINT(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.MIDPOINTContext(context.Background(), params)
+}
+
+// MIDPOINTContext is like MIDPOINT but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) MIDPOINTContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "MIDPOINT"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // MIDPRICE retrieves MIDPRICE data based on the provided parameters.
 func (c *TechnicalIndicatorsService) MIDPRICE(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.MIDPRICEContext(context.Background(), params)
+}
+
+// MIDPRICEContext is like MIDPRICE but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) MIDPRICEContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "MIDPRICE"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // SAR retrieves SAR data based on the provided parameters.
-func (c *TechnicalIndicatorsService) SAR(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) SAR(params types.SARParams) (*types.IndicatorResponse, error) {
+	return c.SARContext(context.Background(), params)
+}
+
+// SARContext is like SAR but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) SARContext(ctx context.Context, params types.SARParams) (*types.IndicatorResponse, error) {
 	params.Function = "SAR"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.number("acceleration", params.Acceleration)
+	options.number("maximum", params.Maximum)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // TRANGE retrieves TRANGE data based on the provided parameters.
 func (c *TechnicalIndicatorsService) TRANGE(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.TRANGEContext(context.Background(), params)
+}
+
+// TRANGEContext is like TRANGE but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) TRANGEContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "TRANGE"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // ATR retrieves ATR data based on the provided parameters.
 func (c *TechnicalIndicatorsService) ATR(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.ATRContext(context.Background(), params)
+}
+
+// ATRContext is like ATR but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) ATRContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "ATR"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // NATR retrieves NATR data based on the provided parameters.
 func (c *TechnicalIndicatorsService) NATR(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.NATRContext(context.Background(), params)
+}
+
+// NATRContext is like NATR but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) NATRContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "NATR"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // AD retrieves AD data based on the provided parameters.
 func (c *TechnicalIndicatorsService) AD(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.ADContext(context.Background(), params)
+}
+
+// ADContext is like AD but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) ADContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "AD"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // ADOSC retrieves ADOSC data based on the provided parameters.
-func (c *TechnicalIndicatorsService) ADOSC(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+func (c *TechnicalIndicatorsService) ADOSC(params types.ADOSCParams) (*types.IndicatorResponse, error) {
+	return c.ADOSCContext(context.Background(), params)
+}
+
+// ADOSCContext is like ADOSC but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) ADOSCContext(ctx context.Context, params types.ADOSCParams) (*types.IndicatorResponse, error) {
 	params.Function = "ADOSC"
-	return c.getIndicator(params)
+	options := newIndicatorOptions(params.Function)
+	options.period("fastperiod", params.FastPeriod)
+	options.period("slowperiod", params.SlowPeriod)
+	return c.getIndicator(ctx, params.IndicatorParams, options)
 }
 
 // OBV retrieves OBV data based on the provided parameters.
 func (c *TechnicalIndicatorsService) OBV(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.OBVContext(context.Background(), params)
+}
+
+// OBVContext is like OBV but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) OBVContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "OBV"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // HTTRENDLINE retrieves HT_TRENDLINE data based on the provided parameters.
 func (c *TechnicalIndicatorsService) HTTRENDLINE(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.HTTRENDLINEContext(context.Background(), params)
+}
+
+// HTTRENDLINEContext is like HTTRENDLINE but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) HTTRENDLINEContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "HT_TRENDLINE"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // HTSINE retrieves HT_SINE data based on the provided parameters.
 func (c *TechnicalIndicatorsService) HTSINE(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.HTSINEContext(context.Background(), params)
+}
+
+// HTSINEContext is like HTSINE but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) HTSINEContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "HT_SINE"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // HTTRENDMODE retrieves HT_TRENDMODE data based on the provided parameters.
 func (c *TechnicalIndicatorsService) HTTRENDMODE(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.HTTRENDMODEContext(context.Background(), params)
+}
+
+// HTTRENDMODEContext is like HTTRENDMODE but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) HTTRENDMODEContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "HT_TRENDMODE"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // HTDCPERIOD retrieves HT_DCPERIOD data based on the provided parameters.
 func (c *TechnicalIndicatorsService) HTDCPERIOD(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.HTDCPERIODContext(context.Background(), params)
+}
+
+// HTDCPERIODContext is like HTDCPERIOD but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) HTDCPERIODContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "HT_DCPERIOD"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // HTDCPHASE retrieves HT_DCPHASE data based on the provided parameters.
 func (c *TechnicalIndicatorsService) HTDCPHASE(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.HTDCPHASEContext(context.Background(), params)
+}
+
+// HTDCPHASEContext is like HTDCPHASE but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) HTDCPHASEContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "HT_DCPHASE"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
 // HTPHASOR retrieves HT_PHASOR data based on the provided parameters.
 func (c *TechnicalIndicatorsService) HTPHASOR(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+	return c.HTPHASORContext(context.Background(), params)
+}
+
+// HTPHASORContext is like HTPHASOR but uses ctx for the underlying request.
+func (c *TechnicalIndicatorsService) HTPHASORContext(ctx context.Context, params types.IndicatorParams) (*types.IndicatorResponse, error) {
 	params.Function = "HT_PHASOR"
-	return c.getIndicator(params)
+	return c.getIndicator(ctx, params, nil)
 }
 
-func (c *TechnicalIndicatorsService) getIndicator(params types.IndicatorParams) (*types.IndicatorResponse, error) {
+// getIndicator validates params, sends only the shared parameters function
+// accepts plus any function specific options, and decodes the response.
+func (c *TechnicalIndicatorsService) getIndicator(ctx context.Context, params types.IndicatorParams, options *indicatorOptions) (*types.IndicatorResponse, error) {
+	symbol := strings.TrimSpace(params.Symbol)
+	if symbol == "" {
+		return nil, types.NewParameterError(params.Function, "symbol", "symbol is required")
+	}
+	interval, err := types.CheckInterval(params.Function, params.Interval, intradayFunctions[params.Function])
+	if err != nil {
+		return nil, err
+	}
+	if !params.Month.IsZero() && !interval.Intraday() {
+		return nil, types.NewParameterError(params.Function, "month", "month requires an intraday interval")
+	}
+	if err := types.CheckMonth(params.Function, params.Month); err != nil {
+		return nil, err
+	}
+
 	queryParams := url.Values{}
-	queryParams.Add("symbol", params.Symbol)
-	queryParams.Add("interval", params.Interval)
-	queryParams.Add("time_period", fmt.Sprintf("%d", params.TimePeriod))
-	queryParams.Add("series_type", params.SeriesType)
+	queryParams.Add("symbol", symbol)
+	queryParams.Add("interval", string(interval))
+	queryParams.Add("month", params.Month.String())
+
+	if timePeriodFunctions[params.Function] {
+		if params.TimePeriod <= 0 {
+			return nil, types.NewParameterError(params.Function, "time_period", "time_period must be positive")
+		}
+		queryParams.Add("time_period", strconv.Itoa(params.TimePeriod))
+	}
 
-	if params.Month != "" {
-		queryParams.Add("month", params.Month)
+	if seriesTypeFunctions[params.Function] {
+		switch seriesType := strings.ToLower(strings.TrimSpace(params.SeriesType)); seriesType {
+		case "close", "open", "high", "low":
+			queryParams.Add("series_type", seriesType)
+		default:
+			return nil, types.NewParameterError(params.Function, "series_type", "series_type must be one of close, open, high, low")
+		}
 	}
 
-	if params.OutputSize != "" {
-		queryParams.Add("outputsize", params.OutputSize)
+	if options != nil {
+		if options.err != nil {
+			return nil, options.err
+		}
+		for key, values := range options.values {
+			queryParams[key] = values
+		}
 	}
 
-	data, err := c.client.Do(params.Function, queryParams)
+	data, err := c.client.DoContext(ctx, params.Function, queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var indicatorResponse types.IndicatorResponse
 	if err := types.UnmarshalIndicatorJSON(&indicatorResponse, data, params.Function); err != nil {
-		return nil, err
+		return nil, types.NewDecodeError(params.Function, err)
 	}
 
 	return &indicatorResponse, nil
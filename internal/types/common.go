@@ -1,7 +1,13 @@
 package types
 
-import "net/url"
+import (
+	"context"
+	"net/url"
+)
 
 type Client interface {
 	Do(string, url.Values) ([]byte, error)
+	DoContext(context.Context, string, url.Values) ([]byte, error)
+	DoCSV(string, url.Values) ([]byte, error)
+	DoCSVContext(context.Context, string, url.Values) ([]byte, error)
 }
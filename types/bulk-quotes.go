@@ -0,0 +1,38 @@
+package types
+
+// BulkQuotesChunkSize is the maximum number of symbols per REALTIME_BULK_QUOTES request.
+const BulkQuotesChunkSize = 100
+
+// BulkQuotesResponse models a single REALTIME_BULK_QUOTES response.
+type BulkQuotesResponse struct {
+	Endpoint string      `json:"endpoint"`
+	Message  string      `json:"message"`
+	Data     []BulkQuote `json:"data"`
+}
+
+// BulkQuote is a realtime quote from REALTIME_BULK_QUOTES. The extended hours
+// fields are invalid outside of pre- and post-market sessions.
+type BulkQuote struct {
+	Symbol                     string      `json:"symbol"`
+	Timestamp                  string      `json:"timestamp"`
+	Open                       float64     `json:"open,string"`
+	High                       float64     `json:"high,string"`
+	Low                        float64     `json:"low,string"`
+	Close                      float64     `json:"close,string"`
+	Volume                     int64       `json:"volume,string"`
+	PreviousClose              float64     `json:"previous_close,string"`
+	Change                     float64     `json:"change,string"`
+	ChangePercent              Percent     `json:"change_percent"`
+	ExtendedHoursQuote         NullFloat64 `json:"extended_hours_quote"`
+	ExtendedHoursChange        NullFloat64 `json:"extended_hours_change"`
+	ExtendedHoursChangePercent NullFloat64 `json:"extended_hours_change_percent"`
+}
+
+// BulkQuotesResult collects quotes across every chunked request, keyed by
+// upper-case symbol. Errors holds an entry for each requested symbol without a
+// quote: ErrSymbolNotReturned when the API omitted it, or the error of the
+// request that covered it.
+type BulkQuotesResult struct {
+	Quotes map[string]BulkQuote
+	Errors map[string]error
+}
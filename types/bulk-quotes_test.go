@@ -0,0 +1,32 @@
+package types
+
+import (
+	"os"
+	"testing"
+)
+
+func TestBulkQuotesResponse_Unmarshal(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/realtime_bulk_quotes.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp BulkQuotesResponse
+	if err := UnmarshalLenient(data, &resp); err != nil {
+		t.Fatalf("UnmarshalLenient returned error: %v", err)
+	}
+	if len(resp.Data) != 2 {
+		t.Fatalf("expected 2 quotes, got %d", len(resp.Data))
+	}
+
+	msft := resp.Data[0]
+	if msft.Symbol != "MSFT" || msft.Close != 448.37 || msft.Volume != 20790271 || msft.ChangePercent != 1.3105 {
+		t.Fatalf("unexpected MSFT quote: %+v", msft)
+	}
+	if !msft.ExtendedHoursQuote.Valid || msft.ExtendedHoursQuote.Float64 != 448.89 {
+		t.Fatalf("unexpected MSFT extended hours quote: %+v", msft.ExtendedHoursQuote)
+	}
+	if aapl := resp.Data[1]; aapl.ExtendedHoursQuote.Valid || aapl.ExtendedHoursChangePercent.Valid {
+		t.Fatalf("expected empty extended hours fields to be invalid: %+v", aapl)
+	}
+}
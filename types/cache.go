@@ -0,0 +1,49 @@
+package types
+
+import "time"
+
+// Cache stores raw Alpha Vantage responses. Implementations must be safe for
+// concurrent use. Keys never contain the API key.
+type Cache interface {
+	// Get returns the cached response for key if present and not expired.
+	Get(key string) ([]byte, bool)
+	// Set stores value under key for ttl.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// CachePolicy decides how long responses are cached per Alpha Vantage function.
+// Functions without an entry in TTLs use DefaultTTL; a zero TTL disables caching.
+type CachePolicy struct {
+	DefaultTTL time.Duration
+	TTLs       map[string]time.Duration
+}
+
+// TTL returns the cache lifetime for function.
+func (p CachePolicy) TTL(function string) time.Duration {
+	if ttl, ok := p.TTLs[function]; ok {
+		return ttl
+	}
+	return p.DefaultTTL
+}
+
+// DefaultCachePolicy caches fundamentals, which change at most daily, for 24
+// hours and leaves market data uncached.
+func DefaultCachePolicy() CachePolicy {
+	day := 24 * time.Hour
+	return CachePolicy{
+		TTLs: map[string]time.Duration{
+			"OVERVIEW":           day,
+			"INCOME_STATEMENT":   day,
+			"BALANCE_SHEET":      day,
+			"CASH_FLOW":          day,
+			"ETF_PROFILE":        day,
+			"DIVIDENDS":          day,
+			"SPLITS":             day,
+			"EARNINGS":           day,
+			"EARNINGS_ESTIMATES": day,
+			"LISTING_STATUS":     day,
+			"EARNINGS_CALENDAR":  day,
+			"IPO_CALENDAR":       day,
+		},
+	}
+}
@@ -0,0 +1,9 @@
+package types
+
+// CommodityParams defines parameters for the commodity endpoints. WTI, BRENT
+// and NATURAL_GAS accept daily, weekly and monthly intervals; the remaining
+// commodities accept monthly, quarterly and annual. An empty Interval uses the
+// API default of monthly.
+type CommodityParams struct {
+	Interval SeriesInterval
+}
@@ -1,5 +1,7 @@
 package types
 
+import "context"
+
 type Client interface {
 	CoreStocks() CoreStocks
 	OptionsData() OptionsData
@@ -14,104 +16,261 @@ type Client interface {
 
 type CoreStocks interface {
 	Intraday(params TimeSeriesParams) (TimeSeriesIntraday, error)
+	IntradayContext(ctx context.Context, params TimeSeriesParams) (TimeSeriesIntraday, error)
 	Daily(params TimeSeriesParams) (TimeSeriesDaily, error)
+	DailyContext(ctx context.Context, params TimeSeriesParams) (TimeSeriesDaily, error)
 	DailyAdjusted(params TimeSeriesParams) (TimeSeriesDailyAdjusted, error)
+	DailyAdjustedContext(ctx context.Context, params TimeSeriesParams) (TimeSeriesDailyAdjusted, error)
 	Weekly(params TimeSeriesParams) (TimeSeriesWeekly, error)
+	WeeklyContext(ctx context.Context, params TimeSeriesParams) (TimeSeriesWeekly, error)
 	WeeklyAdjusted(params TimeSeriesParams) (TimeSeriesWeekly, error)
+	WeeklyAdjustedContext(ctx context.Context, params TimeSeriesParams) (TimeSeriesWeekly, error)
 	Monthly(params TimeSeriesParams) (TimeSeriesMonthly, error)
+	MonthlyContext(ctx context.Context, params TimeSeriesParams) (TimeSeriesMonthly, error)
 	MonthlyAdjusted(params TimeSeriesParams) (TimeSeriesMonthlyAdjusted, error)
+	MonthlyAdjustedContext(ctx context.Context, params TimeSeriesParams) (TimeSeriesMonthlyAdjusted, error)
 	Quote(symbol string) (Quote, error)
+	QuoteContext(ctx context.Context, symbol string) (Quote, error)
+	QuoteWithParams(params QuoteParams) (Quote, error)
+	QuoteWithParamsContext(ctx context.Context, params QuoteParams) (Quote, error)
+	BulkQuotes(symbols []string) (*BulkQuotesResult, error)
+	BulkQuotesContext(ctx context.Context, symbols []string) (*BulkQuotesResult, error)
+	MarketStatus() (*MarketStatusResponse, error)
+	MarketStatusContext(ctx context.Context) (*MarketStatusResponse, error)
 	SymbolSearch(keywords string) (*SymbolSearchResponse, error)
+	SymbolSearchContext(ctx context.Context, keywords string) (*SymbolSearchResponse, error)
 }
 
 type OptionsData interface {
+	RealtimeOptions(params RealtimeOptionsParams) (*OptionsChainResponse, error)
+	RealtimeOptionsContext(ctx context.Context, params RealtimeOptionsParams) (*OptionsChainResponse, error)
+	HistoricalOptions(params HistoricalOptionsParams) (*OptionsChainResponse, error)
+	HistoricalOptionsContext(ctx context.Context, params HistoricalOptionsParams) (*OptionsChainResponse, error)
 }
 
 type AlphaInteligence interface {
 	AnalyticsFixedWindow(params AnalyticsFixedWindowParams) (*AnalyticsFixedWindowResponse, error)
+	AnalyticsFixedWindowContext(ctx context.Context, params AnalyticsFixedWindowParams) (*AnalyticsFixedWindowResponse, error)
 	AnalyticsSlidingWindow(params AnalyticsSlidingWindowParams) (*AnalyticsSlidingWindowResponse, error)
+	AnalyticsSlidingWindowContext(ctx context.Context, params AnalyticsSlidingWindowParams) (*AnalyticsSlidingWindowResponse, error)
+	NewsSentiment(params NewsSentimentParams) (*NewsSentimentResponse, error)
+	NewsSentimentContext(ctx context.Context, params NewsSentimentParams) (*NewsSentimentResponse, error)
+	TopGainersLosers() (*TopGainersLosersResponse, error)
+	TopGainersLosersContext(ctx context.Context) (*TopGainersLosersResponse, error)
+	InsiderTransactions(params InsiderTransactionsParams) (*InsiderTransactionsResponse, error)
+	InsiderTransactionsContext(ctx context.Context, params InsiderTransactionsParams) (*InsiderTransactionsResponse, error)
+	EarningsCallTranscript(params EarningsCallTranscriptParams) (*EarningsCallTranscriptResponse, error)
+	EarningsCallTranscriptContext(ctx context.Context, params EarningsCallTranscriptParams) (*EarningsCallTranscriptResponse, error)
+	EarningsCallTranscriptRange(params EarningsCallTranscriptRangeParams) ([]EarningsCallTranscriptResponse, error)
+	EarningsCallTranscriptRangeContext(ctx context.Context, params EarningsCallTranscriptRangeParams) ([]EarningsCallTranscriptResponse, error)
 }
 
 type FundamentalData interface {
 	CompanyOverview(symbol string) (*CompanyOverviewResponse, error)
+	CompanyOverviewContext(ctx context.Context, symbol string) (*CompanyOverviewResponse, error)
 	IncomeStatement(symbol string) (*IncomeStatementResponse, error)
+	IncomeStatementContext(ctx context.Context, symbol string) (*IncomeStatementResponse, error)
 	BalanceSheet(symbol string) (*BalanceSheetResponse, error)
+	BalanceSheetContext(ctx context.Context, symbol string) (*BalanceSheetResponse, error)
 	CashFlow(symbol string) (*CashFlowResponse, error)
+	CashFlowContext(ctx context.Context, symbol string) (*CashFlowResponse, error)
 	ETFProfile(symbol string) (*ETFProfile, error)
+	ETFProfileContext(ctx context.Context, symbol string) (*ETFProfile, error)
 	Dividends(symbol string) (*DividendsResponse, error)
+	DividendsContext(ctx context.Context, symbol string) (*DividendsResponse, error)
 	Splits(symbol string) (*SplitsResponse, error)
+	SplitsContext(ctx context.Context, symbol string) (*SplitsResponse, error)
+	Earnings(symbol string) (*EarningsResponse, error)
+	EarningsContext(ctx context.Context, symbol string) (*EarningsResponse, error)
+	EarningsEstimates(symbol string) (*EarningsEstimatesResponse, error)
+	EarningsEstimatesContext(ctx context.Context, symbol string) (*EarningsEstimatesResponse, error)
+	ListingStatus(params ListingStatusParams) (*ListingStatusResponse, error)
+	ListingStatusContext(ctx context.Context, params ListingStatusParams) (*ListingStatusResponse, error)
+	EarningsCalendar(params EarningsCalendarParams) (*EarningsCalendarResponse, error)
+	EarningsCalendarContext(ctx context.Context, params EarningsCalendarParams) (*EarningsCalendarResponse, error)
+	IPOCalendar() (*IPOCalendarResponse, error)
+	IPOCalendarContext(ctx context.Context) (*IPOCalendarResponse, error)
 }
 
 type Forex interface {
 	ExchangeRate(params ForexExchangeRateParams) (*CurrencyExchangeRateResponse, error)
+	ExchangeRateContext(ctx context.Context, params ForexExchangeRateParams) (*CurrencyExchangeRateResponse, error)
+	Intraday(params ForexIntradayParams) (*ForexSeriesResponse, error)
+	IntradayContext(ctx context.Context, params ForexIntradayParams) (*ForexSeriesResponse, error)
+	Daily(params ForexDailyParams) (*ForexSeriesResponse, error)
+	DailyContext(ctx context.Context, params ForexDailyParams) (*ForexSeriesResponse, error)
+	Weekly(params ForexWeeklyParams) (*ForexSeriesResponse, error)
+	WeeklyContext(ctx context.Context, params ForexWeeklyParams) (*ForexSeriesResponse, error)
+	Monthly(params ForexMonthlyParams) (*ForexSeriesResponse, error)
+	MonthlyContext(ctx context.Context, params ForexMonthlyParams) (*ForexSeriesResponse, error)
 }
 
 type Crypto interface {
 	ExchangeRate(params CryptoExchangeRateParams) (*CurrencyExchangeRateResponse, error)
+	ExchangeRateContext(ctx context.Context, params CryptoExchangeRateParams) (*CurrencyExchangeRateResponse, error)
 	Intraday(params CryptoIntradayParams) (*CryptoSeriesResponse, error)
+	IntradayContext(ctx context.Context, params CryptoIntradayParams) (*CryptoSeriesResponse, error)
 	Daily(params CryptoDailyParams) (*CryptoSeriesResponse, error)
+	DailyContext(ctx context.Context, params CryptoDailyParams) (*CryptoSeriesResponse, error)
 	Weekly(params CryptoWeeklyParams) (*CryptoSeriesResponse, error)
+	WeeklyContext(ctx context.Context, params CryptoWeeklyParams) (*CryptoSeriesResponse, error)
 	Monthly(params CryptoMonthlyParams) (*CryptoSeriesResponse, error)
+	MonthlyContext(ctx context.Context, params CryptoMonthlyParams) (*CryptoSeriesResponse, error)
 }
 
 type Commodities interface {
+	WTI(params CommodityParams) (*DataSeries, error)
+	WTIContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
+	Brent(params CommodityParams) (*DataSeries, error)
+	BrentContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
+	NaturalGas(params CommodityParams) (*DataSeries, error)
+	NaturalGasContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
+	Copper(params CommodityParams) (*DataSeries, error)
+	CopperContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
+	Aluminum(params CommodityParams) (*DataSeries, error)
+	AluminumContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
+	Wheat(params CommodityParams) (*DataSeries, error)
+	WheatContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
+	Corn(params CommodityParams) (*DataSeries, error)
+	CornContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
+	Cotton(params CommodityParams) (*DataSeries, error)
+	CottonContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
+	Sugar(params CommodityParams) (*DataSeries, error)
+	SugarContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
+	Coffee(params CommodityParams) (*DataSeries, error)
+	CoffeeContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
+	AllCommodities(params CommodityParams) (*DataSeries, error)
+	AllCommoditiesContext(ctx context.Context, params CommodityParams) (*DataSeries, error)
 }
 
 type EconomicIndicators interface {
+	RealGDP(params EconomicIndicatorParams) (*DataSeries, error)
+	RealGDPContext(ctx context.Context, params EconomicIndicatorParams) (*DataSeries, error)
+	RealGDPPerCapita() (*DataSeries, error)
+	RealGDPPerCapitaContext(ctx context.Context) (*DataSeries, error)
+	TreasuryYield(params TreasuryYieldParams) (*DataSeries, error)
+	TreasuryYieldContext(ctx context.Context, params TreasuryYieldParams) (*DataSeries, error)
+	FederalFundsRate(params EconomicIndicatorParams) (*DataSeries, error)
+	FederalFundsRateContext(ctx context.Context, params EconomicIndicatorParams) (*DataSeries, error)
+	CPI(params EconomicIndicatorParams) (*DataSeries, error)
+	CPIContext(ctx context.Context, params EconomicIndicatorParams) (*DataSeries, error)
+	Inflation() (*DataSeries, error)
+	InflationContext(ctx context.Context) (*DataSeries, error)
+	RetailSales() (*DataSeries, error)
+	RetailSalesContext(ctx context.Context) (*DataSeries, error)
+	Durables() (*DataSeries, error)
+	DurablesContext(ctx context.Context) (*DataSeries, error)
+	Unemployment() (*DataSeries, error)
+	UnemploymentContext(ctx context.Context) (*DataSeries, error)
+	NonfarmPayroll() (*DataSeries, error)
+	NonfarmPayrollContext(ctx context.Context) (*DataSeries, error)
 }
 
 type TechnicalIndicators interface {
 	SMA(params IndicatorParams) (*IndicatorResponse, error)
+	SMAContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	WMA(params IndicatorParams) (*IndicatorResponse, error)
+	WMAContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	DEMA(params IndicatorParams) (*IndicatorResponse, error)
+	DEMAContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	TEMA(params IndicatorParams) (*IndicatorResponse, error)
+	TEMAContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	TRIMA(params IndicatorParams) (*IndicatorResponse, error)
+	TRIMAContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	KAMA(params IndicatorParams) (*IndicatorResponse, error)
-	MAMA(params IndicatorParams) (*IndicatorResponse, error)
+	KAMAContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
+	MAMA(params MAMAParams) (*IndicatorResponse, error)
+	MAMAContext(ctx context.Context, params MAMAParams) (*IndicatorResponse, error)
 	VWAP(params IndicatorParams) (*IndicatorResponse, error)
+	VWAPContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	T3(params IndicatorParams) (*IndicatorResponse, error)
-	MACD(params IndicatorParams) (*IndicatorResponse, error)
-	MACDEXT(params IndicatorParams) (*IndicatorResponse, error)
-	STOCH(params IndicatorParams) (*IndicatorResponse, error)
-	STOCHF(params IndicatorParams) (*IndicatorResponse, error)
+	T3Context(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
+	MACD(params MACDParams) (*IndicatorResponse, error)
+	MACDContext(ctx context.Context, params MACDParams) (*IndicatorResponse, error)
+	MACDEXT(params MACDEXTParams) (*IndicatorResponse, error)
+	MACDEXTContext(ctx context.Context, params MACDEXTParams) (*IndicatorResponse, error)
+	STOCH(params STOCHParams) (*IndicatorResponse, error)
+	STOCHContext(ctx context.Context, params STOCHParams) (*IndicatorResponse, error)
+	STOCHF(params STOCHFParams) (*IndicatorResponse, error)
+	STOCHFContext(ctx context.Context, params STOCHFParams) (*IndicatorResponse, error)
 	RSI(params IndicatorParams) (*IndicatorResponse, error)
-	STOCHRSI(params IndicatorParams) (*IndicatorResponse, error)
+	RSIContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
+	STOCHRSI(params STOCHRSIParams) (*IndicatorResponse, error)
+	STOCHRSIContext(ctx context.Context, params STOCHRSIParams) (*IndicatorResponse, error)
 	WILLR(params IndicatorParams) (*IndicatorResponse, error)
+	WILLRContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	ADX(params IndicatorParams) (*IndicatorResponse, error)
+	ADXContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	ADXR(params IndicatorParams) (*IndicatorResponse, error)
-	APO(params IndicatorParams) (*IndicatorResponse, error)
+	ADXRContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
+	APO(params APOParams) (*IndicatorResponse, error)
+	APOContext(ctx context.Context, params APOParams) (*IndicatorResponse, error)
 	AROON(params IndicatorParams) (*IndicatorResponse, error)
+	AROONContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	AROONOSC(params IndicatorParams) (*IndicatorResponse, error)
+	AROONOSCContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	BOP(params IndicatorParams) (*IndicatorResponse, error)
+	BOPContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	CCI(params IndicatorParams) (*IndicatorResponse, error)
+	CCIContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	CMO(params IndicatorParams) (*IndicatorResponse, error)
+	CMOContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	ROC(params IndicatorParams) (*IndicatorResponse, error)
+	ROCContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	ROCR(params IndicatorParams) (*IndicatorResponse, error)
+	ROCRContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	MFI(params IndicatorParams) (*IndicatorResponse, error)
+	MFIContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	TRIX(params IndicatorParams) (*IndicatorResponse, error)
-	ULTOSC(params IndicatorParams) (*IndicatorResponse, error)
+	TRIXContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
+	ULTOSC(params ULTOSCParams) (*IndicatorResponse, error)
+	ULTOSCContext(ctx context.Context, params ULTOSCParams) (*IndicatorResponse, error)
 	DX(params IndicatorParams) (*IndicatorResponse, error)
+	DXContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	MINUSDI(params IndicatorParams) (*IndicatorResponse, error)
+	MINUSDIContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	PLUSDI(params IndicatorParams) (*IndicatorResponse, error)
+	PLUSDIContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	MINUSDM(params IndicatorParams) (*IndicatorResponse, error)
+	MINUSDMContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	PLUSDM(params IndicatorParams) (*IndicatorResponse, error)
-	PPO(params IndicatorParams) (*IndicatorResponse, error)
+	PLUSDMContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
+	PPO(params PPOParams) (*IndicatorResponse, error)
+	PPOContext(ctx context.Context, params PPOParams) (*IndicatorResponse, error)
 	MOM(params IndicatorParams) (*IndicatorResponse, error)
-	BBANDS(params IndicatorParams) (*IndicatorResponse, error)
+	MOMContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
+	BBANDS(params BBANDSParams) (*IndicatorResponse, error)
+	BBANDSContext(ctx context.Context, params BBANDSParams) (*IndicatorResponse, error)
 	MIDPOINT(params IndicatorParams) (*IndicatorResponse, error)
+	MIDPOINTContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	MIDPRICE(params IndicatorParams) (*IndicatorResponse, error)
-	SAR(params IndicatorParams) (*IndicatorResponse, error)
+	MIDPRICEContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
+	SAR(params SARParams) (*IndicatorResponse, error)
+	SARContext(ctx context.Context, params SARParams) (*IndicatorResponse, error)
 	TRANGE(params IndicatorParams) (*IndicatorResponse, error)
+	TRANGEContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	ATR(params IndicatorParams) (*IndicatorResponse, error)
+	ATRContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	NATR(params IndicatorParams) (*IndicatorResponse, error)
+	NATRContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	AD(params IndicatorParams) (*IndicatorResponse, error)
+	ADContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	EMA(params IndicatorParams) (*IndicatorResponse, error)
+	EMAContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	HTDCPHASE(params IndicatorParams) (*IndicatorResponse, error)
+	HTDCPHASEContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	HTDCPERIOD(params IndicatorParams) (*IndicatorResponse, error)
+	HTDCPERIODContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	HTPHASOR(params IndicatorParams) (*IndicatorResponse, error)
+	HTPHASORContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	HTSINE(params IndicatorParams) (*IndicatorResponse, error)
+	HTSINEContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	HTTRENDLINE(params IndicatorParams) (*IndicatorResponse, error)
+	HTTRENDLINEContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	HTTRENDMODE(params IndicatorParams) (*IndicatorResponse, error)
+	HTTRENDMODEContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
 	OBV(params IndicatorParams) (*IndicatorResponse, error)
-	ADOSC(params IndicatorParams) (*IndicatorResponse, error)
+	OBVContext(ctx context.Context, params IndicatorParams) (*IndicatorResponse, error)
+	ADOSC(params ADOSCParams) (*IndicatorResponse, error)
+	ADOSCContext(ctx context.Context, params ADOSCParams) (*IndicatorResponse, error)
 }
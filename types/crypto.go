@@ -17,8 +17,8 @@ type CryptoExchangeRateParams struct {
 type CryptoIntradayParams struct {
 	Symbol     string
 	Market     string
-	Interval   string
-	OutputSize string
+	Interval   Interval
+	OutputSize OutputSize
 }
 
 type CryptoDailyParams struct {
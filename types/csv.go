@@ -0,0 +1,69 @@
+package types
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// csvRecords parses a CSV payload with a header row into one map per record,
+// keyed by column name. It fails if any of the required columns is missing.
+func csvRecords(data []byte, required ...string) ([]map[string]string, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+	for _, name := range required {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("csv: missing column %q", name)
+		}
+	}
+
+	var out []map[string]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]string, len(index))
+		for name, i := range index {
+			if i < len(record) {
+				row[name] = strings.TrimSpace(record[i])
+			}
+		}
+		out = append(out, row)
+	}
+}
+
+// csvNullFloat parses a CSV cell, treating empty and placeholder values as missing.
+func csvNullFloat(s string) (NullFloat64, error) {
+	var n NullFloat64
+	err := n.UnmarshalJSON([]byte(s))
+	return n, err
+}
+
+// csvDate normalizes a CSV date cell, mapping "null" to an empty string.
+func csvDate(s string) string {
+	if strings.EqualFold(s, "null") {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SeriesInterval is the sampling interval of a commodity or economic series.
+type SeriesInterval string
+
+const (
+	SeriesDaily      SeriesInterval = "daily"
+	SeriesWeekly     SeriesInterval = "weekly"
+	SeriesMonthly    SeriesInterval = "monthly"
+	SeriesQuarterly  SeriesInterval = "quarterly"
+	SeriesSemiannual SeriesInterval = "semiannual"
+	SeriesAnnual     SeriesInterval = "annual"
+)
+
+// CheckSeriesInterval normalizes interval and verifies that function accepts
+// it. An empty interval is returned unchanged so the API default applies.
+func CheckSeriesInterval(function string, interval SeriesInterval, allowed ...SeriesInterval) (SeriesInterval, error) {
+	interval = SeriesInterval(strings.ToLower(strings.TrimSpace(string(interval))))
+	if interval == "" {
+		return "", nil
+	}
+
+	names := make([]string, len(allowed))
+	for i, a := range allowed {
+		if a == interval {
+			return interval, nil
+		}
+		names[i] = string(a)
+	}
+	return "", NewParameterError(function, "interval", "interval must be one of "+strings.Join(names, ", "))
+}
+
+// DataSeries models the name/interval/unit/data response shared by the
+// commodity and economic indicator endpoints. Data is sorted oldest first.
+type DataSeries struct {
+	Name     string         `json:"name"`
+	Interval SeriesInterval `json:"interval"`
+	Unit     string         `json:"unit"`
+	Data     []DataPoint    `json:"data"`
+}
+
+// DataPoint is a single observation. Alpha Vantage reports missing
+// observations as "."; those decode with Valid set to false and a zero Value.
+type DataPoint struct {
+	Date  time.Time
+	Value float64
+	Valid bool
+}
+
+// UnmarshalJSON decodes the series, normalizes its interval to the lower-case
+// SeriesInterval constants and sorts its observations by date.
+func (s *DataSeries) UnmarshalJSON(data []byte) error {
+	type plain DataSeries
+	var raw plain
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	raw.Interval = SeriesInterval(strings.ToLower(strings.TrimSpace(string(raw.Interval))))
+
+	sort.SliceStable(raw.Data, func(a, b int) bool {
+		return raw.Data[a].Date.Before(raw.Data[b].Date)
+	})
+
+	*s = DataSeries(raw)
+	return nil
+}
+
+// UnmarshalJSON decodes a {"date": ..., "value": ...} observation.
+func (p *DataPoint) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Date  string `json:"date"`
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	date, err := time.Parse("2006-01-02", raw.Date)
+	if err != nil {
+		return err
+	}
+
+	*p = DataPoint{Date: date}
+
+	value := strings.TrimSpace(raw.Value)
+	if value == "." || value == "" || isNAString(value) {
+		return nil
+	}
+
+	p.Value, err = strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("invalid value %q for %s: %w", raw.Value, raw.Date, err)
+	}
+	p.Valid = true
+	return nil
+}
+
+// Observations returns only the points with a reported value.
+func (s DataSeries) Observations() []DataPoint {
+	out := make([]DataPoint, 0, len(s.Data))
+	for _, p := range s.Data {
+		if p.Valid {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// Latest returns the most recent point with a reported value.
+func (s DataSeries) Latest() (DataPoint, bool) {
+	for i := len(s.Data) - 1; i >= 0; i-- {
+		if s.Data[i].Valid {
+			return s.Data[i], true
+		}
+	}
+	return DataPoint{}, false
+}
+
+// Between returns the points dated within [from, to]. A zero from or to leaves
+// that side of the range open.
+func (s DataSeries) Between(from, to time.Time) []DataPoint {
+	var out []DataPoint
+	for _, p := range s.Data {
+		if !from.IsZero() && p.Date.Before(from) {
+			continue
+		}
+		if !to.IsZero() && p.Date.After(to) {
+			continue
+		}
+		out = append(out, p)
+	}
+	return out
+}
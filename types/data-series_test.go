@@ -0,0 +1,58 @@
+package types
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDataSeries_UnmarshalHandlesMissingValues(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/commodity_WTI_daily.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var series DataSeries
+	if err := UnmarshalLenient(data, &series); err != nil {
+		t.Fatalf("UnmarshalLenient returned error: %v", err)
+	}
+
+	if series.Name != "Crude Oil Prices WTI" || series.Interval != SeriesDaily || series.Unit != "dollars per barrel" {
+		t.Fatalf("unexpected metadata: %+v", series)
+	}
+	if got, err := CheckSeriesInterval("WTI", series.Interval, SeriesDaily, SeriesWeekly); err != nil || got != SeriesDaily {
+		t.Fatalf("expected the decoded interval to be accepted as a parameter, got %q, %v", got, err)
+	}
+	if len(series.Data) != 5 {
+		t.Fatalf("expected 5 points, got %d", len(series.Data))
+	}
+
+	first := series.Data[0]
+	if !first.Date.Equal(time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC)) || !first.Valid || first.Value != 70.10 {
+		t.Fatalf("expected oldest point first, got %+v", first)
+	}
+	if missing := series.Data[1]; missing.Valid || missing.Value != 0 {
+		t.Fatalf("expected \".\" to decode as a missing point, got %+v", missing)
+	}
+
+	if got := series.Observations(); len(got) != 3 {
+		t.Fatalf("expected 3 observations, got %d", len(got))
+	}
+	latest, ok := series.Latest()
+	if !ok || latest.Value != 71.29 {
+		t.Fatalf("unexpected latest point: %+v, %v", latest, ok)
+	}
+
+	from := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC)
+	if got := series.Between(from, to); len(got) != 3 {
+		t.Fatalf("expected 3 points in range, got %d", len(got))
+	}
+}
+
+func TestDataPoint_UnmarshalRejectsInvalidValue(t *testing.T) {
+	var p DataPoint
+	if err := p.UnmarshalJSON([]byte(`{"date": "2024-01-01", "value": "abc"}`)); err == nil {
+		t.Fatal("expected error for non-numeric value")
+	}
+}
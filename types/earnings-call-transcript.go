@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EarningsCallTranscriptParams defines parameters for the
+// EARNINGS_CALL_TRANSCRIPT endpoint. Quarter is in YYYYQn form, e.g. 2024Q1.
+type EarningsCallTranscriptParams struct {
+	Symbol  string
+	Quarter string
+}
+
+// EarningsCallTranscriptRangeParams selects every quarter from the one
+// containing From through the one containing To.
+type EarningsCallTranscriptRangeParams struct {
+	Symbol string
+	From   time.Time
+	To     time.Time
+}
+
+// EarningsCallTranscriptResponse models the EARNINGS_CALL_TRANSCRIPT response.
+type EarningsCallTranscriptResponse struct {
+	Symbol     string        `json:"symbol"`
+	Quarter    string        `json:"quarter"`
+	Transcript []SpeakerTurn `json:"transcript"`
+}
+
+// SpeakerTurn is one uninterrupted passage of an earnings call. Sentiment
+// ranges from -1 (bearish) to 1 (bullish).
+type SpeakerTurn struct {
+	Speaker   string  `json:"speaker"`
+	Title     string  `json:"title"`
+	Content   string  `json:"content"`
+	Sentiment float64 `json:"sentiment,string"`
+}
+
+// Quarter is a calendar year and quarter number from 1 to 4.
+type Quarter struct {
+	Year    int
+	Quarter int
+}
+
+// ParseQuarter parses a quarter in YYYYQn form, e.g. 2024Q1.
+func ParseQuarter(s string) (Quarter, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	year, q, ok := strings.Cut(s, "Q")
+	if !ok || len(year) != 4 || len(q) != 1 {
+		return Quarter{}, fmt.Errorf("invalid quarter %q: expected YYYYQn, e.g. 2024Q1", s)
+	}
+
+	for i := 0; i < len(year); i++ {
+		if year[i] < '0' || year[i] > '9' {
+			return Quarter{}, fmt.Errorf("invalid quarter %q: expected YYYYQn, e.g. 2024Q1", s)
+		}
+	}
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		return Quarter{}, fmt.Errorf("invalid quarter %q: expected YYYYQn, e.g. 2024Q1", s)
+	}
+	n := int(q[0] - '0')
+	if n < 1 || n > 4 {
+		return Quarter{}, fmt.Errorf("invalid quarter %q: quarter must be 1 to 4", s)
+	}
+
+	return Quarter{Year: y, Quarter: n}, nil
+}
+
+// QuarterOf returns the calendar quarter containing t.
+func QuarterOf(t time.Time) Quarter {
+	return Quarter{Year: t.Year(), Quarter: (int(t.Month())-1)/3 + 1}
+}
+
+// QuartersBetween returns every quarter from the one containing from through
+// the one containing to, in order. It returns nil when to is before from.
+func QuartersBetween(from, to time.Time) []Quarter {
+	first, last := QuarterOf(from), QuarterOf(to)
+	if last.Before(first) {
+		return nil
+	}
+
+	var out []Quarter
+	for q := first; !last.Before(q); q = q.Next() {
+		out = append(out, q)
+	}
+	return out
+}
+
+// String formats q as YYYYQn.
+func (q Quarter) String() string {
+	return fmt.Sprintf("%04dQ%d", q.Year, q.Quarter)
+}
+
+// Next returns the following quarter.
+func (q Quarter) Next() Quarter {
+	if q.Quarter == 4 {
+		return Quarter{Year: q.Year + 1, Quarter: 1}
+	}
+	return Quarter{Year: q.Year, Quarter: q.Quarter + 1}
+}
+
+// Before reports whether q is earlier than other.
+func (q Quarter) Before(other Quarter) bool {
+	if q.Year != other.Year {
+		return q.Year < other.Year
+	}
+	return q.Quarter < other.Quarter
+}
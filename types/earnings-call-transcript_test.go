@@ -0,0 +1,62 @@
+package types
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestEarningsCallTranscriptResponse_Unmarshal(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/earnings_call_transcript_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp EarningsCallTranscriptResponse
+	if err := UnmarshalLenient(data, &resp); err != nil {
+		t.Fatalf("UnmarshalLenient returned error: %v", err)
+	}
+
+	if resp.Symbol != "IBM" || resp.Quarter != "2024Q1" || len(resp.Transcript) != 3 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if turn := resp.Transcript[1]; turn.Speaker != "Arvind Krishna" || turn.Title != "Chairman and Chief Executive Officer" || turn.Sentiment != 0.8 {
+		t.Fatalf("unexpected speaker turn: %+v", turn)
+	}
+}
+
+func TestParseQuarter(t *testing.T) {
+	q, err := ParseQuarter(" 2024q3 ")
+	if err != nil {
+		t.Fatalf("ParseQuarter returned error: %v", err)
+	}
+	if q != (Quarter{Year: 2024, Quarter: 3}) || q.String() != "2024Q3" {
+		t.Fatalf("unexpected quarter: %+v", q)
+	}
+
+	for _, s := range []string{"", "2024", "2024Q0", "2024Q5", "24Q1", "2024-Q1", "abcdQ1", "2024Q12", "-202Q1", "+202Q1", "２０２４Q1"} {
+		if _, err := ParseQuarter(s); err == nil {
+			t.Fatalf("expected error for %q", s)
+		}
+	}
+}
+
+func TestQuartersBetween(t *testing.T) {
+	from := time.Date(2023, 11, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	got := QuartersBetween(from, to)
+	want := []string{"2023Q4", "2024Q1", "2024Q2", "2024Q3"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d quarters, got %v", len(want), got)
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Fatalf("quarter %d: expected %s, got %s", i, want[i], got[i])
+		}
+	}
+
+	if got := QuartersBetween(to, from); got != nil {
+		t.Fatalf("expected nil for inverted range, got %v", got)
+	}
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EarningsResponse models the EARNINGS API response.
+type EarningsResponse struct {
+	Symbol            string              `json:"symbol"`
+	AnnualEarnings    []AnnualEarnings    `json:"annualEarnings"`
+	QuarterlyEarnings []QuarterlyEarnings `json:"quarterlyEarnings"`
+}
+
+// AnnualEarnings is the reported EPS for a fiscal year.
+type AnnualEarnings struct {
+	FiscalDateEnding string      `json:"fiscalDateEnding"`
+	ReportedEPS      NullFloat64 `json:"reportedEPS"`
+}
+
+// QuarterlyEarnings is the reported and estimated EPS for a fiscal quarter.
+// ReportTime is "pre-market" or "post-market".
+type QuarterlyEarnings struct {
+	FiscalDateEnding   string      `json:"fiscalDateEnding"`
+	ReportedDate       string      `json:"reportedDate"`
+	ReportedEPS        NullFloat64 `json:"reportedEPS"`
+	EstimatedEPS       NullFloat64 `json:"estimatedEPS"`
+	Surprise           NullFloat64 `json:"surprise"`
+	SurprisePercentage NullFloat64 `json:"surprisePercentage"`
+	ReportTime         string      `json:"reportTime"`
+}
+
+// EarningsEstimatesResponse models the EARNINGS_ESTIMATES API response.
+type EarningsEstimatesResponse struct {
+	Symbol    string             `json:"symbol"`
+	Estimates []EarningsEstimate `json:"estimates"`
+}
+
+// EarningsEstimate holds analyst EPS and revenue estimates for one fiscal
+// period, e.g. Horizon "next fiscal quarter", together with recent revisions.
+type EarningsEstimate struct {
+	Date                          string      `json:"date"`
+	Horizon                       string      `json:"horizon"`
+	EPSEstimateAverage            NullFloat64 `json:"eps_estimate_average"`
+	EPSEstimateHigh               NullFloat64 `json:"eps_estimate_high"`
+	EPSEstimateLow                NullFloat64 `json:"eps_estimate_low"`
+	EPSEstimateAnalystCount       NullFloat64 `json:"eps_estimate_analyst_count"`
+	EPSEstimateAverage7DaysAgo    NullFloat64 `json:"eps_estimate_average_7_days_ago"`
+	EPSEstimateAverage30DaysAgo   NullFloat64 `json:"eps_estimate_average_30_days_ago"`
+	EPSEstimateAverage60DaysAgo   NullFloat64 `json:"eps_estimate_average_60_days_ago"`
+	EPSEstimateAverage90DaysAgo   NullFloat64 `json:"eps_estimate_average_90_days_ago"`
+	EPSEstimateRevisionUp7Days    NullFloat64 `json:"eps_estimate_revision_up_trailing_7_days"`
+	EPSEstimateRevisionDown7Days  NullFloat64 `json:"eps_estimate_revision_down_trailing_7_days"`
+	EPSEstimateRevisionUp30Days   NullFloat64 `json:"eps_estimate_revision_up_trailing_30_days"`
+	EPSEstimateRevisionDown30Days NullFloat64 `json:"eps_estimate_revision_down_trailing_30_days"`
+	RevenueEstimateAverage        NullFloat64 `json:"revenue_estimate_average"`
+	RevenueEstimateHigh           NullFloat64 `json:"revenue_estimate_high"`
+	RevenueEstimateLow            NullFloat64 `json:"revenue_estimate_low"`
+	RevenueEstimateAnalystCount   NullFloat64 `json:"revenue_estimate_analyst_count"`
+}
+
+// String renders a concise summary.
+func (r EarningsResponse) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Earnings for %s\n", r.Symbol))
+	sb.WriteString(fmt.Sprintf("Annual reports: %d | Quarterly reports: %d\n", len(r.AnnualEarnings), len(r.QuarterlyEarnings)))
+	if len(r.QuarterlyEarnings) > 0 {
+		q := r.QuarterlyEarnings[0]
+		sb.WriteString(fmt.Sprintf("Latest quarter: %s reported %s (%s) EPS %s vs %s est.\n",
+			q.FiscalDateEnding, q.ReportedDate, q.ReportTime, q.ReportedEPS, q.EstimatedEPS))
+	}
+	return sb.String()
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func TestEarningsResponse_DistinguishesNoneFromZero(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/earnings_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp EarningsResponse
+	if err := UnmarshalLenient(data, &resp); err != nil {
+		t.Fatalf("UnmarshalLenient returned error: %v", err)
+	}
+
+	if resp.Symbol != "IBM" || len(resp.AnnualEarnings) != 2 || len(resp.QuarterlyEarnings) != 3 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if eps := resp.AnnualEarnings[0].ReportedEPS; !eps.Valid || eps.Float64 != 10.33 {
+		t.Fatalf("unexpected annual EPS: %+v", eps)
+	}
+
+	latest := resp.QuarterlyEarnings[0]
+	if latest.ReportedEPS.Float64 != 3.92 || latest.EstimatedEPS.Float64 != 3.77 || latest.SurprisePercentage.Float64 != 3.9788 || latest.ReportTime != "post-market" {
+		t.Fatalf("unexpected latest quarter: %+v", latest)
+	}
+
+	inline := resp.QuarterlyEarnings[1]
+	if !inline.Surprise.Valid || inline.Surprise.Float64 != 0 {
+		t.Fatalf("expected a reported zero surprise, got %+v", inline.Surprise)
+	}
+
+	old := resp.QuarterlyEarnings[2]
+	if old.EstimatedEPS.Valid || old.SurprisePercentage.Valid {
+		t.Fatalf("expected None estimates to be invalid, got %+v", old)
+	}
+	if !old.Surprise.Valid {
+		t.Fatalf("expected zero surprise to be valid, got %+v", old.Surprise)
+	}
+}
+
+func TestEarningsEstimatesResponse_Unmarshal(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/earnings_estimates_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp EarningsEstimatesResponse
+	if err := UnmarshalLenient(data, &resp); err != nil {
+		t.Fatalf("UnmarshalLenient returned error: %v", err)
+	}
+
+	if len(resp.Estimates) != 1 {
+		t.Fatalf("expected 1 estimate, got %d", len(resp.Estimates))
+	}
+	e := resp.Estimates[0]
+	if e.Horizon != "next fiscal quarter" || e.EPSEstimateAverage.Float64 != 1.6594 || e.EPSEstimateAnalystCount.Float64 != 17 {
+		t.Fatalf("unexpected estimate: %+v", e)
+	}
+	if e.EPSEstimateRevisionDown7Days.Valid || !e.EPSEstimateRevisionUp7Days.Valid {
+		t.Fatalf("unexpected revision validity: %+v %+v", e.EPSEstimateRevisionUp7Days, e.EPSEstimateRevisionDown7Days)
+	}
+	if e.RevenueEstimateAverage.Float64 != 14403810000 {
+		t.Fatalf("unexpected revenue estimate: %v", e.RevenueEstimateAverage)
+	}
+}
+
+func TestNullFloat64_JSON(t *testing.T) {
+	cases := map[string]NullFloat64{
+		`"1.25"`: {Float64: 1.25, Valid: true},
+		`0`:      {Float64: 0, Valid: true},
+		`"0"`:    {Float64: 0, Valid: true},
+		`"None"`: {},
+		`"-"`:    {},
+		`null`:   {},
+	}
+	for input, want := range cases {
+		var n NullFloat64
+		if err := json.Unmarshal([]byte(input), &n); err != nil {
+			t.Fatalf("Unmarshal(%s) returned error: %v", input, err)
+		}
+		if n != want {
+			t.Fatalf("Unmarshal(%s) = %+v, want %+v", input, n, want)
+		}
+	}
+
+	var n NullFloat64
+	if err := json.Unmarshal([]byte(`"abc"`), &n); err == nil {
+		t.Fatal("expected error for non-numeric value")
+	}
+
+	out, err := json.Marshal([]NullFloat64{{Float64: 2.5, Valid: true}, {}})
+	if err != nil || string(out) != `[2.5,null]` {
+		t.Fatalf("unexpected Marshal output %s, %v", out, err)
+	}
+}
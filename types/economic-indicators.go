@@ -0,0 +1,29 @@
+package types
+
+// EconomicIndicatorParams defines the optional interval for economic indicator
+// endpoints. REAL_GDP accepts quarterly and annual; FEDERAL_FUNDS_RATE accepts
+// daily, weekly and monthly; CPI accepts monthly and semiannual. An empty
+// Interval uses the API default for the endpoint.
+type EconomicIndicatorParams struct {
+	Interval SeriesInterval
+}
+
+// TreasuryMaturity selects the constant maturity for TREASURY_YIELD.
+type TreasuryMaturity string
+
+const (
+	Maturity3Month TreasuryMaturity = "3month"
+	Maturity2Year  TreasuryMaturity = "2year"
+	Maturity5Year  TreasuryMaturity = "5year"
+	Maturity7Year  TreasuryMaturity = "7year"
+	Maturity10Year TreasuryMaturity = "10year"
+	Maturity30Year TreasuryMaturity = "30year"
+)
+
+// TreasuryYieldParams defines parameters for the TREASURY_YIELD endpoint.
+// Interval accepts daily, weekly and monthly; an empty Maturity uses the API
+// default of 10year.
+type TreasuryYieldParams struct {
+	Interval SeriesInterval
+	Maturity TreasuryMaturity
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors for classifying failures with errors.Is.
+var (
+	// ErrRateLimited reports an Alpha Vantage throttle notice (the server-side
+	// quota was exceeded). See ErrRateLimitExceeded for the client-side limiter.
+	ErrRateLimited = errors.New("alpha vantage: rate limited")
+	// ErrPremiumRequired reports that the endpoint or parameter requires a premium plan.
+	ErrPremiumRequired = errors.New("alpha vantage: premium endpoint")
+	// ErrInvalidAPICall reports an "Error Message" response, e.g. an unknown symbol.
+	ErrInvalidAPICall = errors.New("alpha vantage: invalid API call")
+	// ErrInformation reports any other "Information" message returned instead of data.
+	ErrInformation = errors.New("alpha vantage: information")
+	// ErrInvalidParameter reports a request rejected by client-side validation.
+	ErrInvalidParameter = errors.New("alpha vantage: invalid parameter")
+	// ErrHTTPStatus reports a non-2xx HTTP response.
+	ErrHTTPStatus = errors.New("alpha vantage: unexpected HTTP status")
+	// ErrDecode reports a response body that could not be decoded.
+	ErrDecode = errors.New("alpha vantage: decode failure")
+	// ErrSymbolNotReturned reports a requested symbol missing from a bulk response.
+	ErrSymbolNotReturned = errors.New("alpha vantage: symbol not returned")
+)
+
+// APIError is returned when Alpha Vantage answers with a top-level "Note",
+// "Information" or "Error Message" payload instead of data. Kind is one of
+// ErrRateLimited, ErrPremiumRequired, ErrInvalidAPICall or ErrInformation.
+type APIError struct {
+	Function string
+	Kind     error
+	Key      string
+	Message  string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("alpha vantage %s %s: %s", e.Function, strings.ToLower(e.Key), e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Kind
+}
+
+// ParameterError is returned when request parameters fail validation before
+// any HTTP call is made.
+type ParameterError struct {
+	Function  string
+	Parameter string
+	Message   string
+}
+
+// NewParameterError returns a ParameterError for parameter of function.
+func NewParameterError(function, parameter, message string) error {
+	return &ParameterError{Function: function, Parameter: parameter, Message: message}
+}
+
+func (e *ParameterError) Error() string {
+	return fmt.Sprintf("alpha vantage %s: %s", e.Function, e.Message)
+}
+
+func (e *ParameterError) Unwrap() error {
+	return ErrInvalidParameter
+}
+
+// HTTPStatusError is returned for non-2xx HTTP responses.
+type HTTPStatusError struct {
+	Function   string
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("alpha vantage %s: unexpected HTTP status %s", e.Function, e.Status)
+}
+
+func (e *HTTPStatusError) Unwrap() error {
+	return ErrHTTPStatus
+}
+
+// DecodeError is returned when a response body cannot be decoded into the
+// typed response. Err holds the underlying decoder error.
+type DecodeError struct {
+	Function string
+	Err      error
+}
+
+// NewDecodeError wraps err as a DecodeError for function.
+func NewDecodeError(function string, err error) error {
+	return &DecodeError{Function: function, Err: err}
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("alpha vantage %s: decode response: %v", e.Function, e.Err)
+}
+
+func (e *DecodeError) Unwrap() []error {
+	return []error{ErrDecode, e.Err}
+}
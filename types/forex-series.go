@@ -0,0 +1,159 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ForexIntradayParams defines the request parameters for the FX_INTRADAY endpoint.
+type ForexIntradayParams struct {
+	FromSymbol string
+	ToSymbol   string
+	Interval   Interval
+	OutputSize OutputSize
+}
+
+// ForexDailyParams defines the request parameters for the FX_DAILY endpoint.
+type ForexDailyParams struct {
+	FromSymbol string
+	ToSymbol   string
+	OutputSize OutputSize
+}
+
+// ForexWeeklyParams defines the request parameters for the FX_WEEKLY endpoint.
+type ForexWeeklyParams struct {
+	FromSymbol string
+	ToSymbol   string
+}
+
+// ForexMonthlyParams defines the request parameters for the FX_MONTHLY endpoint.
+type ForexMonthlyParams struct {
+	FromSymbol string
+	ToSymbol   string
+}
+
+// ForexSeriesResponse models the FX_INTRADAY, FX_DAILY, FX_WEEKLY and
+// FX_MONTHLY responses. TimeSeries is sorted oldest first.
+type ForexSeriesResponse struct {
+	MetaData      ForexMetaData
+	TimeSeries    []ForexTimeSeriesData
+	IntervalLabel string
+}
+
+type ForexMetaData struct {
+	Information   string
+	FromSymbol    string
+	ToSymbol      string
+	LastRefreshed string
+	Interval      string
+	OutputSize    string
+	TimeZone      string
+}
+
+type ForexTimeSeriesData struct {
+	Timestamp time.Time
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+}
+
+func UnmarshalForexJSON(f *ForexSeriesResponse, data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	metaData, ok := raw["Meta Data"].(map[string]interface{})
+	if ok {
+		f.MetaData = extractForexMetaData(metaData)
+	}
+
+	for tsKey, tsData := range raw {
+		if !strings.HasPrefix(tsKey, "Time Series FX") {
+			continue
+		}
+
+		f.IntervalLabel = tsKey
+		timeSeriesMap, ok := tsData.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected map for time series data")
+		}
+
+		for date, values := range timeSeriesMap {
+			timestamp, err := parseForexTimestamp(date)
+			if err != nil {
+				return err
+			}
+
+			valuesMap, ok := values.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("expected map for timestamp data")
+			}
+
+			var bar ForexTimeSeriesData
+			bar.Timestamp = timestamp
+			for key, dst := range map[string]*float64{
+				"1. open":  &bar.Open,
+				"2. high":  &bar.High,
+				"3. low":   &bar.Low,
+				"4. close": &bar.Close,
+			} {
+				v, err := strconv.ParseFloat(asString(valuesMap[key]), 64)
+				if err != nil {
+					return fmt.Errorf("invalid %q for %s: %w", key, date, err)
+				}
+				*dst = v
+			}
+
+			f.TimeSeries = append(f.TimeSeries, bar)
+		}
+	}
+
+	sort.SliceStable(f.TimeSeries, func(a, b int) bool {
+		return f.TimeSeries[a].Timestamp.Before(f.TimeSeries[b].Timestamp)
+	})
+
+	return nil
+}
+
+func parseForexTimestamp(s string) (time.Time, error) {
+	if len(s) == len("2006-01-02") {
+		return time.Parse("2006-01-02", s)
+	}
+	return time.Parse("2006-01-02 15:04:05", s)
+}
+
+// extractForexMetaData matches keys by name rather than number because the
+// numbering differs between the intraday, daily and weekly/monthly responses.
+func extractForexMetaData(rawData map[string]interface{}) ForexMetaData {
+	var metaData ForexMetaData
+
+	for key, value := range rawData {
+		if i := strings.Index(key, ". "); i >= 0 {
+			key = key[i+2:]
+		}
+
+		switch key {
+		case "Information":
+			metaData.Information = asString(value)
+		case "From Symbol":
+			metaData.FromSymbol = asString(value)
+		case "To Symbol":
+			metaData.ToSymbol = asString(value)
+		case "Last Refreshed":
+			metaData.LastRefreshed = asString(value)
+		case "Interval":
+			metaData.Interval = asString(value)
+		case "Output Size":
+			metaData.OutputSize = asString(value)
+		case "Time Zone":
+			metaData.TimeZone = asString(value)
+		}
+	}
+	return metaData
+}
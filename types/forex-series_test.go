@@ -0,0 +1,67 @@
+package types
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestUnmarshalForexJSON_Daily(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/fx_daily_EURUSD.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp ForexSeriesResponse
+	if err := UnmarshalForexJSON(&resp, data); err != nil {
+		t.Fatalf("UnmarshalForexJSON returned error: %v", err)
+	}
+
+	if resp.MetaData.FromSymbol != "EUR" || resp.MetaData.ToSymbol != "USD" || resp.MetaData.OutputSize != "Compact" {
+		t.Fatalf("unexpected metadata: %+v", resp.MetaData)
+	}
+	if resp.MetaData.LastRefreshed != "2024-12-31 00:00:00" || resp.MetaData.TimeZone != "UTC" {
+		t.Fatalf("unexpected metadata: %+v", resp.MetaData)
+	}
+	if resp.IntervalLabel != "Time Series FX (Daily)" || len(resp.TimeSeries) != 3 {
+		t.Fatalf("unexpected series: %s, %d bars", resp.IntervalLabel, len(resp.TimeSeries))
+	}
+
+	first, last := resp.TimeSeries[0], resp.TimeSeries[2]
+	if !first.Timestamp.Equal(time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC)) || first.Close != 1.04260 {
+		t.Fatalf("expected oldest bar first, got %+v", first)
+	}
+	if last.Open != 1.04060 || last.High != 1.04250 || last.Low != 1.03430 || last.Close != 1.03550 {
+		t.Fatalf("unexpected last bar: %+v", last)
+	}
+}
+
+func TestUnmarshalForexJSON_Intraday(t *testing.T) {
+	data := []byte(`{
+		"Meta Data": {
+			"1. Information": "FX Intraday (5min) Time Series",
+			"2. From Symbol": "EUR",
+			"3. To Symbol": "USD",
+			"4. Last Refreshed": "2024-12-31 21:55:00",
+			"5. Interval": "5min",
+			"6. Output Size": "Compact",
+			"7. Time Zone": "UTC"
+		},
+		"Time Series FX (5min)": {
+			"2024-12-31 21:55:00": {"1. open": "1.03540", "2. high": "1.03560", "3. low": "1.03520", "4. close": "1.03550"},
+			"2024-12-31 21:50:00": {"1. open": "1.03530", "2. high": "1.03550", "3. low": "1.03510", "4. close": "1.03540"}
+		}
+	}`)
+
+	var resp ForexSeriesResponse
+	if err := UnmarshalForexJSON(&resp, data); err != nil {
+		t.Fatalf("UnmarshalForexJSON returned error: %v", err)
+	}
+
+	if resp.MetaData.Interval != "5min" || resp.MetaData.TimeZone != "UTC" {
+		t.Fatalf("unexpected metadata: %+v", resp.MetaData)
+	}
+	if len(resp.TimeSeries) != 2 || resp.TimeSeries[0].Timestamp.Minute() != 50 {
+		t.Fatalf("unexpected series: %+v", resp.TimeSeries)
+	}
+}
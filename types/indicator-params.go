@@ -0,0 +1,124 @@
+package types
+
+// MAType selects the moving average used inside indicators such as BBANDS,
+// MACDEXT and STOCH. The zero value is MATypeSMA, which is also the API default.
+type MAType int
+
+const (
+	MATypeSMA MAType = iota
+	MATypeEMA
+	MATypeWMA
+	MATypeDEMA
+	MATypeTEMA
+	MATypeTRIMA
+	MATypeT3
+	MATypeKAMA
+	MATypeMAMA
+)
+
+// Valid reports whether m is one of the moving average types the API accepts.
+func (m MAType) Valid() bool {
+	return m >= MATypeSMA && m <= MATypeMAMA
+}
+
+// The option structs below embed IndicatorParams and add the parameters only
+// their indicator accepts. Zero-valued options are omitted so the API default
+// applies.
+
+// MAMAParams are the parameters for MAMA.
+type MAMAParams struct {
+	IndicatorParams
+	FastLimit float64
+	SlowLimit float64
+}
+
+// MACDParams are the parameters for MACD.
+type MACDParams struct {
+	IndicatorParams
+	FastPeriod   int
+	SlowPeriod   int
+	SignalPeriod int
+}
+
+// MACDEXTParams are the parameters for MACDEXT.
+type MACDEXTParams struct {
+	IndicatorParams
+	FastPeriod   int
+	SlowPeriod   int
+	SignalPeriod int
+	FastMAType   MAType
+	SlowMAType   MAType
+	SignalMAType MAType
+}
+
+// STOCHParams are the parameters for STOCH.
+type STOCHParams struct {
+	IndicatorParams
+	FastKPeriod int
+	SlowKPeriod int
+	SlowDPeriod int
+	SlowKMAType MAType
+	SlowDMAType MAType
+}
+
+// STOCHFParams are the parameters for STOCHF.
+type STOCHFParams struct {
+	IndicatorParams
+	FastKPeriod int
+	FastDPeriod int
+	FastDMAType MAType
+}
+
+// STOCHRSIParams are the parameters for STOCHRSI.
+type STOCHRSIParams struct {
+	IndicatorParams
+	FastKPeriod int
+	FastDPeriod int
+	FastDMAType MAType
+}
+
+// APOParams are the parameters for APO.
+type APOParams struct {
+	IndicatorParams
+	FastPeriod int
+	SlowPeriod int
+	MAType     MAType
+}
+
+// PPOParams are the parameters for PPO.
+type PPOParams struct {
+	IndicatorParams
+	FastPeriod int
+	SlowPeriod int
+	MAType     MAType
+}
+
+// ULTOSCParams are the parameters for ULTOSC. TimePeriod is not used.
+type ULTOSCParams struct {
+	IndicatorParams
+	TimePeriod1 int
+	TimePeriod2 int
+	TimePeriod3 int
+}
+
+// BBANDSParams are the parameters for BBANDS.
+type BBANDSParams struct {
+	IndicatorParams
+	NbDevUp int
+	NbDevDn int
+	MAType  MAType
+}
+
+// SARParams are the parameters for SAR.
+type SARParams struct {
+	IndicatorParams
+	Acceleration float64
+	Maximum      float64
+}
+
+// ADOSCParams are the parameters for ADOSC.
+type ADOSCParams struct {
+	IndicatorParams
+	FastPeriod int
+	SlowPeriod int
+}
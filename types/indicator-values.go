@@ -0,0 +1,224 @@
+package types
+
+import "time"
+
+// The typed accessors below read the named outputs of multi-line indicators
+// from IndicatorValue.Values. They report false when any output is missing,
+// for example when the response came from a different indicator. Values
+// remains available for outputs without an accessor.
+
+// MACDValue is one MACD or MACDEXT data point.
+type MACDValue struct {
+	Timestamp time.Time
+	MACD      float64
+	Signal    float64
+	Histogram float64
+}
+
+// StochasticValue is one STOCH, STOCHF or STOCHRSI data point. K and D hold the
+// slow lines for STOCH and the fast lines for STOCHF and STOCHRSI.
+type StochasticValue struct {
+	Timestamp time.Time
+	K         float64
+	D         float64
+}
+
+// AroonValue is one AROON data point.
+type AroonValue struct {
+	Timestamp time.Time
+	Up        float64
+	Down      float64
+}
+
+// BollingerBandsValue is one BBANDS data point.
+type BollingerBandsValue struct {
+	Timestamp time.Time
+	Upper     float64
+	Middle    float64
+	Lower     float64
+}
+
+// MAMAValue is one MAMA data point.
+type MAMAValue struct {
+	Timestamp time.Time
+	MAMA      float64
+	FAMA      float64
+}
+
+// HTSineValue is one HT_SINE data point.
+type HTSineValue struct {
+	Timestamp time.Time
+	Sine      float64
+	LeadSine  float64
+}
+
+// HTPhasorValue is one HT_PHASOR data point.
+type HTPhasorValue struct {
+	Timestamp  time.Time
+	Phase      float64
+	Quadrature float64
+}
+
+// MACD returns v as a MACD data point.
+func (v IndicatorValue) MACD() (MACDValue, bool) {
+	out, ok := v.outputs("MACD", "MACD_Signal", "MACD_Hist")
+	if !ok {
+		return MACDValue{}, false
+	}
+	return MACDValue{Timestamp: v.Timestamp, MACD: out[0], Signal: out[1], Histogram: out[2]}, true
+}
+
+// Stochastic returns v as a stochastic data point, reading SlowK/SlowD when
+// present and FastK/FastD otherwise.
+func (v IndicatorValue) Stochastic() (StochasticValue, bool) {
+	out, ok := v.outputs("SlowK", "SlowD")
+	if !ok {
+		out, ok = v.outputs("FastK", "FastD")
+	}
+	if !ok {
+		return StochasticValue{}, false
+	}
+	return StochasticValue{Timestamp: v.Timestamp, K: out[0], D: out[1]}, true
+}
+
+// Aroon returns v as an AROON data point.
+func (v IndicatorValue) Aroon() (AroonValue, bool) {
+	out, ok := v.outputs("Aroon Up", "Aroon Down")
+	if !ok {
+		return AroonValue{}, false
+	}
+	return AroonValue{Timestamp: v.Timestamp, Up: out[0], Down: out[1]}, true
+}
+
+// BollingerBands returns v as a BBANDS data point.
+func (v IndicatorValue) BollingerBands() (BollingerBandsValue, bool) {
+	out, ok := v.outputs("Real Upper Band", "Real Middle Band", "Real Lower Band")
+	if !ok {
+		return BollingerBandsValue{}, false
+	}
+	return BollingerBandsValue{Timestamp: v.Timestamp, Upper: out[0], Middle: out[1], Lower: out[2]}, true
+}
+
+// MAMA returns v as a MAMA data point.
+func (v IndicatorValue) MAMA() (MAMAValue, bool) {
+	out, ok := v.outputs("MAMA", "FAMA")
+	if !ok {
+		return MAMAValue{}, false
+	}
+	return MAMAValue{Timestamp: v.Timestamp, MAMA: out[0], FAMA: out[1]}, true
+}
+
+// HTSine returns v as an HT_SINE data point.
+func (v IndicatorValue) HTSine() (HTSineValue, bool) {
+	out, ok := v.outputs("SINE", "LEAD SINE")
+	if !ok {
+		return HTSineValue{}, false
+	}
+	return HTSineValue{Timestamp: v.Timestamp, Sine: out[0], LeadSine: out[1]}, true
+}
+
+// HTPhasor returns v as an HT_PHASOR data point.
+func (v IndicatorValue) HTPhasor() (HTPhasorValue, bool) {
+	out, ok := v.outputs("PHASE", "QUADRATURE")
+	if !ok {
+		return HTPhasorValue{}, false
+	}
+	return HTPhasorValue{Timestamp: v.Timestamp, Phase: out[0], Quadrature: out[1]}, true
+}
+
+// outputs returns the named outputs in order, or false if any is missing.
+func (v IndicatorValue) outputs(names ...string) ([]float64, bool) {
+	out := make([]float64, len(names))
+	for n, name := range names {
+		f, ok := v.Values[name]
+		if !ok {
+			return nil, false
+		}
+		out[n] = f
+	}
+	return out, true
+}
+
+// MACDValues returns the data points of a MACD or MACDEXT response, oldest
+// first, skipping points with missing outputs.
+func (i IndicatorResponse) MACDValues() []MACDValue {
+	var out []MACDValue
+	for _, v := range i.IndicatorValues {
+		if p, ok := v.MACD(); ok {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// StochasticValues returns the data points of a STOCH, STOCHF or STOCHRSI
+// response, oldest first, skipping points with missing outputs.
+func (i IndicatorResponse) StochasticValues() []StochasticValue {
+	var out []StochasticValue
+	for _, v := range i.IndicatorValues {
+		if p, ok := v.Stochastic(); ok {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// AroonValues returns the data points of an AROON response, oldest first,
+// skipping points with missing outputs.
+func (i IndicatorResponse) AroonValues() []AroonValue {
+	var out []AroonValue
+	for _, v := range i.IndicatorValues {
+		if p, ok := v.Aroon(); ok {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// BollingerBandsValues returns the data points of a BBANDS response, oldest
+// first, skipping points with missing outputs.
+func (i IndicatorResponse) BollingerBandsValues() []BollingerBandsValue {
+	var out []BollingerBandsValue
+	for _, v := range i.IndicatorValues {
+		if p, ok := v.BollingerBands(); ok {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// MAMAValues returns the data points of a MAMA response, oldest first,
+// skipping points with missing outputs.
+func (i IndicatorResponse) MAMAValues() []MAMAValue {
+	var out []MAMAValue
+	for _, v := range i.IndicatorValues {
+		if p, ok := v.MAMA(); ok {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// HTSineValues returns the data points of an HT_SINE response, oldest first,
+// skipping points with missing outputs.
+func (i IndicatorResponse) HTSineValues() []HTSineValue {
+	var out []HTSineValue
+	for _, v := range i.IndicatorValues {
+		if p, ok := v.HTSine(); ok {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// HTPhasorValues returns the data points of an HT_PHASOR response, oldest
+// first, skipping points with missing outputs.
+func (i IndicatorResponse) HTPhasorValues() []HTPhasorValue {
+	var out []HTPhasorValue
+	for _, v := range i.IndicatorValues {
+		if p, ok := v.HTPhasor(); ok {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"os"
+	"testing"
+)
+
+func TestIndicatorResponse_BollingerBandsValues(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/technical_BBANDS_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp IndicatorResponse
+	if err := UnmarshalIndicatorJSON(&resp, data, "BBANDS"); err != nil {
+		t.Fatalf("UnmarshalIndicatorJSON returned error: %v", err)
+	}
+
+	bands := resp.BollingerBandsValues()
+	if len(bands) != 2 {
+		t.Fatalf("expected 2 points, got %d", len(bands))
+	}
+	latest := bands[1]
+	if latest.Upper != 178.4521 || latest.Middle != 176.0120 || latest.Lower != 173.5719 {
+		t.Fatalf("unexpected latest bands: %+v", latest)
+	}
+	if !latest.Timestamp.Equal(resp.IndicatorValues[1].Timestamp) {
+		t.Fatalf("expected timestamps to match the generic values")
+	}
+	if resp.IndicatorValues[1].Values["Real Upper Band"] != latest.Upper {
+		t.Fatal("expected the generic map to remain populated")
+	}
+}
+
+func TestIndicatorResponse_StochasticValuesReadsSlowAndFastLines(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/technical_STOCH_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp IndicatorResponse
+	if err := UnmarshalIndicatorJSON(&resp, data, "STOCH"); err != nil {
+		t.Fatalf("UnmarshalIndicatorJSON returned error: %v", err)
+	}
+	if got := resp.StochasticValues(); len(got) != 1 || got[0].K != 81.2210 || got[0].D != 74.6603 {
+		t.Fatalf("unexpected STOCH values: %+v", got)
+	}
+
+	fast := IndicatorValue{Values: map[string]float64{"FastK": 12, "FastD": 9}}
+	if got, ok := fast.Stochastic(); !ok || got.K != 12 || got.D != 9 {
+		t.Fatalf("unexpected STOCHF value: %+v, %v", got, ok)
+	}
+}
+
+func TestIndicatorValue_TypedAccessors(t *testing.T) {
+	v := IndicatorValue{Values: map[string]float64{
+		"MACD": 1.2, "MACD_Signal": 0.9, "MACD_Hist": 0.3,
+		"Aroon Up": 85.7, "Aroon Down": 14.3,
+		"MAMA": 176.1, "FAMA": 175.4,
+		"SINE": 0.5, "LEAD SINE": 0.9,
+		"PHASE": 2.1, "QUADRATURE": -1.4,
+	}}
+
+	if got, ok := v.MACD(); !ok || got.MACD != 1.2 || got.Signal != 0.9 || got.Histogram != 0.3 {
+		t.Fatalf("unexpected MACD: %+v, %v", got, ok)
+	}
+	if got, ok := v.Aroon(); !ok || got.Up != 85.7 || got.Down != 14.3 {
+		t.Fatalf("unexpected Aroon: %+v, %v", got, ok)
+	}
+	if got, ok := v.MAMA(); !ok || got.MAMA != 176.1 || got.FAMA != 175.4 {
+		t.Fatalf("unexpected MAMA: %+v, %v", got, ok)
+	}
+	if got, ok := v.HTSine(); !ok || got.Sine != 0.5 || got.LeadSine != 0.9 {
+		t.Fatalf("unexpected HT_SINE: %+v, %v", got, ok)
+	}
+	if got, ok := v.HTPhasor(); !ok || got.Phase != 2.1 || got.Quadrature != -1.4 {
+		t.Fatalf("unexpected HT_PHASOR: %+v, %v", got, ok)
+	}
+}
+
+func TestIndicatorValue_TypedAccessorsReportMissingOutputs(t *testing.T) {
+	sma := IndicatorValue{Values: map[string]float64{"SMA": 176.2}}
+	if _, ok := sma.MACD(); ok {
+		t.Fatal("expected MACD to report missing outputs")
+	}
+	if _, ok := sma.BollingerBands(); ok {
+		t.Fatal("expected BollingerBands to report missing outputs")
+	}
+
+	partial := IndicatorValue{Values: map[string]float64{"SlowK": 80, "FastD": 70}}
+	if _, ok := partial.Stochastic(); ok {
+		t.Fatal("expected Stochastic to require both lines of one pair")
+	}
+
+	resp := IndicatorResponse{IndicatorValues: []IndicatorValue{
+		{Values: map[string]float64{"Aroon Up": 60, "Aroon Down": 40}},
+		{Values: map[string]float64{"Aroon Up": 70}},
+	}}
+	if got := resp.AroonValues(); len(got) != 1 || got[0].Up != 60 {
+		t.Fatalf("expected incomplete points to be skipped, got %+v", got)
+	}
+}
@@ -22,11 +22,11 @@ import (
 type IndicatorParams struct {
 	Function   string
 	Symbol     string
-	Interval   string
+	Interval   Interval
 	TimePeriod int
 	SeriesType string
-	Month      string
-	OutputSize string
+	// Month selects a month of history and requires an intraday Interval.
+	Month YearMonth
 }
 
 type IndicatorResponse struct {
@@ -58,7 +58,11 @@ func UnmarshalIndicatorJSON(i *IndicatorResponse, data []byte, indicatorName str
 	// Extracting the indicator values
 	if tsData, exists := raw[expectedKey].(map[string]interface{}); exists {
 		for k, v := range tsData {
+			// Intraday keys carry a time; daily and longer intervals are dates.
 			timestamp, err := time.Parse("2006-01-02 15:04", k)
+			if err != nil {
+				timestamp, err = time.Parse("2006-01-02", k)
+			}
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+// InsiderTransactionsParams defines parameters for the INSIDER_TRANSACTIONS endpoint.
+type InsiderTransactionsParams struct {
+	Symbol string
+}
+
+// InsiderTransactionsResponse models the INSIDER_TRANSACTIONS response.
+type InsiderTransactionsResponse struct {
+	Data []InsiderTransaction `json:"data"`
+}
+
+// InsiderTransaction is a single reported insider trade.
+type InsiderTransaction struct {
+	TransactionDate       string      `json:"transaction_date"`
+	Ticker                string      `json:"ticker"`
+	Executive             string      `json:"executive"`
+	ExecutiveTitle        string      `json:"executive_title"`
+	SecurityType          string      `json:"security_type"`
+	AcquisitionOrDisposal string      `json:"acquisition_or_disposal"`
+	Shares                NullFloat64 `json:"shares"`
+	SharePrice            NullFloat64 `json:"share_price"`
+}
+
+// Date parses TransactionDate.
+func (t InsiderTransaction) Date() (time.Time, error) {
+	return time.Parse("2006-01-02", t.TransactionDate)
+}
+
+// IsAcquisition reports whether the insider acquired shares ("A").
+func (t InsiderTransaction) IsAcquisition() bool {
+	return strings.EqualFold(strings.TrimSpace(t.AcquisitionOrDisposal), "A")
+}
+
+// IsDisposal reports whether the insider disposed of shares ("D").
+func (t InsiderTransaction) IsDisposal() bool {
+	return strings.EqualFold(strings.TrimSpace(t.AcquisitionOrDisposal), "D")
+}
+
+// IsCommonStock reports whether the transaction is in the issuer's common
+// stock, as opposed to options, restricted stock units or other derivatives.
+func (t InsiderTransaction) IsCommonStock() bool {
+	return strings.Contains(strings.ToLower(t.SecurityType), "common stock")
+}
+
+// Value is the notional value of the transaction, Shares × SharePrice. It is
+// not valid when either the share count or the share price is blank.
+func (t InsiderTransaction) Value() NullFloat64 {
+	if !t.Shares.Valid || !t.SharePrice.Valid {
+		return NullFloat64{}
+	}
+	return NullFloat64{Float64: t.Shares.Float64 * t.SharePrice.Float64, Valid: true}
+}
+
+// InsiderActivity summarizes insider transactions for one symbol. Net values
+// are acquisitions minus disposals, so a positive NetShares means net buying.
+type InsiderActivity struct {
+	Symbol         string
+	Transactions   int
+	SharesAcquired float64
+	SharesDisposed float64
+	NetShares      float64
+	ValueAcquired  float64
+	ValueDisposed  float64
+	NetValue       float64
+}
+
+// NetBuying aggregates the response's transactions dated within [from, to].
+// See NetInsiderBuying.
+func (r InsiderTransactionsResponse) NetBuying(from, to time.Time) map[string]InsiderActivity {
+	return NetInsiderBuying(r.Data, from, to)
+}
+
+// NetInsiderBuying aggregates common stock transactions dated within [from, to]
+// into per-symbol activity keyed by upper-case ticker. A zero from or to leaves
+// that side of the window open. Other security types, such as options and
+// restricted stock units, are skipped, as are transactions with an unparseable
+// date, an unknown acquisition/disposal flag or a blank share count. A blank
+// share price counts toward the share totals but not the value totals.
+func NetInsiderBuying(transactions []InsiderTransaction, from, to time.Time) map[string]InsiderActivity {
+	out := make(map[string]InsiderActivity)
+	for _, t := range transactions {
+		if !t.IsCommonStock() || !t.Shares.Valid {
+			continue
+		}
+		if !t.IsAcquisition() && !t.IsDisposal() {
+			continue
+		}
+
+		date, err := t.Date()
+		if err != nil {
+			continue
+		}
+		if !from.IsZero() && date.Before(from) {
+			continue
+		}
+		if !to.IsZero() && date.After(to) {
+			continue
+		}
+
+		symbol := strings.ToUpper(strings.TrimSpace(t.Ticker))
+		a := out[symbol]
+		a.Symbol = symbol
+		a.Transactions++
+		value := t.Value().Float64
+		if t.IsAcquisition() {
+			a.SharesAcquired += t.Shares.Float64
+			a.ValueAcquired += value
+		} else {
+			a.SharesDisposed += t.Shares.Float64
+			a.ValueDisposed += value
+		}
+		a.NetShares = a.SharesAcquired - a.SharesDisposed
+		a.NetValue = a.ValueAcquired - a.ValueDisposed
+		out[symbol] = a
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func loadInsiderTransactions(t *testing.T) InsiderTransactionsResponse {
+	t.Helper()
+	data, err := os.ReadFile("../models/testdata/insider_transactions_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp InsiderTransactionsResponse
+	if err := UnmarshalLenient(data, &resp); err != nil {
+		t.Fatalf("UnmarshalLenient returned error: %v", err)
+	}
+	return resp
+}
+
+func TestInsiderTransactionsResponse_Unmarshal(t *testing.T) {
+	resp := loadInsiderTransactions(t)
+
+	if len(resp.Data) != 5 {
+		t.Fatalf("expected 5 transactions, got %d", len(resp.Data))
+	}
+
+	tx := resp.Data[1]
+	if tx.Executive != "ROE, JANE" || tx.ExecutiveTitle != "Director" || tx.SecurityType != "Common Stock" {
+		t.Fatalf("unexpected transaction: %+v", tx)
+	}
+	if !tx.IsAcquisition() || tx.IsDisposal() || tx.Shares.Float64 != 4000 || tx.SharePrice.Float64 != 210 || tx.Value().Float64 != 840000 {
+		t.Fatalf("unexpected transaction amounts: %+v", tx)
+	}
+	if !tx.IsCommonStock() || resp.Data[2].IsCommonStock() {
+		t.Fatalf("unexpected security types: %q, %q", tx.SecurityType, resp.Data[2].SecurityType)
+	}
+}
+
+func TestInsiderTransaction_EmptySharePrice(t *testing.T) {
+	resp := loadInsiderTransactions(t)
+
+	tx := resp.Data[3]
+	if tx.SharePrice.Valid {
+		t.Fatalf("expected blank share price to be invalid, got %+v", tx.SharePrice)
+	}
+	if !tx.Shares.Valid || tx.Shares.Float64 != 200 {
+		t.Fatalf("unexpected shares: %+v", tx.Shares)
+	}
+	if tx.Value().Valid {
+		t.Fatalf("expected value to be invalid without a share price, got %+v", tx.Value())
+	}
+}
+
+func TestNetInsiderBuying_Window(t *testing.T) {
+	resp := loadInsiderTransactions(t)
+
+	from := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	activity := resp.NetBuying(from, to)
+
+	ibm, ok := activity["IBM"]
+	if !ok || len(activity) != 1 {
+		t.Fatalf("expected activity for IBM only, got %+v", activity)
+	}
+	if ibm.Transactions != 3 || ibm.SharesAcquired != 4200 || ibm.SharesDisposed != 1500 || ibm.NetShares != 2700 {
+		t.Fatalf("unexpected share totals: %+v", ibm)
+	}
+	if ibm.ValueAcquired != 840000 || ibm.ValueDisposed != 345000 || ibm.NetValue != 495000 {
+		t.Fatalf("unexpected value totals: %+v", ibm)
+	}
+
+	all := NetInsiderBuying(resp.Data, time.Time{}, time.Time{})["IBM"]
+	if all.Transactions != 4 || all.NetShares != -7300 {
+		t.Fatalf("unexpected open-window totals: %+v", all)
+	}
+}
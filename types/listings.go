@@ -0,0 +1,165 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// ListingState selects active or delisted securities for LISTING_STATUS.
+type ListingState string
+
+const (
+	ListingActive   ListingState = "active"
+	ListingDelisted ListingState = "delisted"
+)
+
+// ListingStatusParams defines parameters for the LISTING_STATUS endpoint. A
+// zero Date returns listings as of the latest trading day; otherwise Date must
+// be no earlier than 2010-01-01. An empty State returns active listings.
+type ListingStatusParams struct {
+	Date  time.Time
+	State ListingState
+}
+
+// ListingStatusResponse holds the parsed LISTING_STATUS CSV.
+type ListingStatusResponse struct {
+	Listings []Listing
+}
+
+// Listing is a US stock or ETF from LISTING_STATUS. DelistingDate is empty
+// for active listings.
+type Listing struct {
+	Symbol        string
+	Name          string
+	Exchange      string
+	AssetType     string
+	IPODate       string
+	DelistingDate string
+	Status        string
+}
+
+// EarningsHorizon selects how far ahead EARNINGS_CALENDAR looks.
+type EarningsHorizon string
+
+const (
+	Horizon3Month  EarningsHorizon = "3month"
+	Horizon6Month  EarningsHorizon = "6month"
+	Horizon12Month EarningsHorizon = "12month"
+)
+
+// EarningsCalendarParams defines parameters for the EARNINGS_CALENDAR
+// endpoint. An empty Symbol returns all companies; an empty Horizon uses the
+// API default of 3month.
+type EarningsCalendarParams struct {
+	Symbol  string
+	Horizon EarningsHorizon
+}
+
+// EarningsCalendarResponse holds the parsed EARNINGS_CALENDAR CSV.
+type EarningsCalendarResponse struct {
+	Entries []EarningsCalendarEntry
+}
+
+// EarningsCalendarEntry is an expected earnings report.
+type EarningsCalendarEntry struct {
+	Symbol           string
+	Name             string
+	ReportDate       string
+	FiscalDateEnding string
+	Estimate         NullFloat64
+	Currency         string
+}
+
+// IPOCalendarResponse holds the parsed IPO_CALENDAR CSV.
+type IPOCalendarResponse struct {
+	Entries []IPOCalendarEntry
+}
+
+// IPOCalendarEntry is an IPO expected in the next three months. Price range
+// bounds are invalid when the CSV leaves them empty.
+type IPOCalendarEntry struct {
+	Symbol         string
+	Name           string
+	IPODate        string
+	PriceRangeLow  NullFloat64
+	PriceRangeHigh NullFloat64
+	Currency       string
+	Exchange       string
+}
+
+// ParseListingStatusCSV parses a LISTING_STATUS CSV payload.
+func ParseListingStatusCSV(data []byte) (*ListingStatusResponse, error) {
+	rows, err := csvRecords(data, "symbol", "name", "exchange", "assetType", "ipoDate", "delistingDate", "status")
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListingStatusResponse{Listings: make([]Listing, 0, len(rows))}
+	for _, row := range rows {
+		resp.Listings = append(resp.Listings, Listing{
+			Symbol:        row["symbol"],
+			Name:          row["name"],
+			Exchange:      row["exchange"],
+			AssetType:     row["assetType"],
+			IPODate:       csvDate(row["ipoDate"]),
+			DelistingDate: csvDate(row["delistingDate"]),
+			Status:        row["status"],
+		})
+	}
+	return resp, nil
+}
+
+// ParseEarningsCalendarCSV parses an EARNINGS_CALENDAR CSV payload.
+func ParseEarningsCalendarCSV(data []byte) (*EarningsCalendarResponse, error) {
+	rows, err := csvRecords(data, "symbol", "name", "reportDate", "fiscalDateEnding", "estimate", "currency")
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &EarningsCalendarResponse{Entries: make([]EarningsCalendarEntry, 0, len(rows))}
+	for _, row := range rows {
+		estimate, err := csvNullFloat(row["estimate"])
+		if err != nil {
+			return nil, fmt.Errorf("%s: estimate: %w", row["symbol"], err)
+		}
+		resp.Entries = append(resp.Entries, EarningsCalendarEntry{
+			Symbol:           row["symbol"],
+			Name:             row["name"],
+			ReportDate:       row["reportDate"],
+			FiscalDateEnding: row["fiscalDateEnding"],
+			Estimate:         estimate,
+			Currency:         row["currency"],
+		})
+	}
+	return resp, nil
+}
+
+// ParseIPOCalendarCSV parses an IPO_CALENDAR CSV payload.
+func ParseIPOCalendarCSV(data []byte) (*IPOCalendarResponse, error) {
+	rows, err := csvRecords(data, "symbol", "name", "ipoDate", "priceRangeLow", "priceRangeHigh", "currency", "exchange")
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &IPOCalendarResponse{Entries: make([]IPOCalendarEntry, 0, len(rows))}
+	for _, row := range rows {
+		low, err := csvNullFloat(row["priceRangeLow"])
+		if err != nil {
+			return nil, fmt.Errorf("%s: priceRangeLow: %w", row["symbol"], err)
+		}
+		high, err := csvNullFloat(row["priceRangeHigh"])
+		if err != nil {
+			return nil, fmt.Errorf("%s: priceRangeHigh: %w", row["symbol"], err)
+		}
+		resp.Entries = append(resp.Entries, IPOCalendarEntry{
+			Symbol:         row["symbol"],
+			Name:           row["name"],
+			IPODate:        row["ipoDate"],
+			PriceRangeLow:  low,
+			PriceRangeHigh: high,
+			Currency:       row["currency"],
+			Exchange:       row["exchange"],
+		})
+	}
+	return resp, nil
+}
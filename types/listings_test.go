@@ -0,0 +1,82 @@
+package types
+
+import (
+	"os"
+	"testing"
+)
+
+func TestParseListingStatusCSV(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/listing_status_delisted.csv")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	resp, err := ParseListingStatusCSV(data)
+	if err != nil {
+		t.Fatalf("ParseListingStatusCSV returned error: %v", err)
+	}
+	if len(resp.Listings) != 3 {
+		t.Fatalf("expected 3 listings, got %d", len(resp.Listings))
+	}
+
+	if active := resp.Listings[0]; active.Symbol != "A" || active.DelistingDate != "" || active.Status != "Active" {
+		t.Fatalf("unexpected active listing: %+v", active)
+	}
+	quoted := resp.Listings[2]
+	if quoted.Symbol != "ABC,D" || quoted.Name != "ABC & D Holdings" || quoted.AssetType != "ETF" || quoted.DelistingDate != "2020-03-31" {
+		t.Fatalf("unexpected quoted listing: %+v", quoted)
+	}
+}
+
+func TestParseEarningsCalendarCSV(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/earnings_calendar_IBM.csv")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	resp, err := ParseEarningsCalendarCSV(data)
+	if err != nil {
+		t.Fatalf("ParseEarningsCalendarCSV returned error: %v", err)
+	}
+	if len(resp.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(resp.Entries))
+	}
+	if e := resp.Entries[0]; e.ReportDate != "2025-04-23" || !e.Estimate.Valid || e.Estimate.Float64 != 1.66 || e.Currency != "USD" {
+		t.Fatalf("unexpected entry: %+v", e)
+	}
+	if e := resp.Entries[1]; e.Estimate.Valid {
+		t.Fatalf("expected empty estimate to be invalid, got %+v", e.Estimate)
+	}
+}
+
+func TestParseIPOCalendarCSV(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/ipo_calendar.csv")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	resp, err := ParseIPOCalendarCSV(data)
+	if err != nil {
+		t.Fatalf("ParseIPOCalendarCSV returned error: %v", err)
+	}
+	if len(resp.Entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(resp.Entries))
+	}
+	if e := resp.Entries[0]; e.PriceRangeLow.Float64 != 14 || e.PriceRangeHigh.Float64 != 16 || e.Exchange != "NASDAQ" {
+		t.Fatalf("unexpected entry: %+v", e)
+	}
+	if e := resp.Entries[2]; e.PriceRangeLow.Valid || e.PriceRangeHigh.Valid {
+		t.Fatalf("expected unpriced IPO to have invalid range, got %+v", e)
+	}
+}
+
+func TestParseListingStatusCSV_Errors(t *testing.T) {
+	if _, err := ParseListingStatusCSV([]byte("symbol,name\nIBM,International Business Machines\n")); err == nil {
+		t.Fatal("expected error for missing columns")
+	}
+
+	resp, err := ParseListingStatusCSV(nil)
+	if err != nil || len(resp.Listings) != 0 {
+		t.Fatalf("expected empty response for empty payload, got %+v, %v", resp, err)
+	}
+}
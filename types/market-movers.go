@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TopGainersLosersResponse models the TOP_GAINERS_LOSERS response.
+type TopGainersLosersResponse struct {
+	Metadata           string        `json:"metadata"`
+	LastUpdated        string        `json:"last_updated"`
+	TopGainers         []MarketMover `json:"top_gainers"`
+	TopLosers          []MarketMover `json:"top_losers"`
+	MostActivelyTraded []MarketMover `json:"most_actively_traded"`
+}
+
+// MarketMover is a single ticker in a TOP_GAINERS_LOSERS list.
+type MarketMover struct {
+	Ticker           string  `json:"ticker"`
+	Price            float64 `json:"price,string"`
+	ChangeAmount     float64 `json:"change_amount,string"`
+	ChangePercentage Percent `json:"change_percentage"`
+	Volume           int64   `json:"volume,string"`
+}
+
+// Percent is a percentage decoded from strings like "68.49%". The value is in
+// percent units, so "68.49%" decodes as 68.49.
+type Percent float64
+
+// UnmarshalJSON accepts a JSON string with or without a trailing "%", or a number.
+func (p *Percent) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var f float64
+		if ferr := json.Unmarshal(data, &f); ferr != nil {
+			return err
+		}
+		*p = Percent(f)
+		return nil
+	}
+
+	s = strings.TrimSuffix(strings.TrimSpace(s), "%")
+	if s == "" || isNAString(s) {
+		*p = 0
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("invalid percentage %q: %w", s, err)
+	}
+	*p = Percent(f)
+	return nil
+}
+
+// LastUpdatedAt parses LastUpdated, e.g. "2024-12-31 16:15:59 US/Eastern".
+func (r TopGainersLosersResponse) LastUpdatedAt() (time.Time, error) {
+	value, zone, _ := strings.Cut(strings.TrimSpace(r.LastUpdated), " US/")
+	loc := time.UTC
+	if zone != "" {
+		l, err := time.LoadLocation("US/" + zone)
+		if err != nil {
+			return time.Time{}, err
+		}
+		loc = l
+	}
+	return time.ParseInLocation("2006-01-02 15:04:05", value, loc)
+}
+
+// String renders a concise summary.
+func (r TopGainersLosersResponse) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Top Gainers/Losers (updated %s)\n", r.LastUpdated))
+	for _, list := range []struct {
+		name    string
+		entries []MarketMover
+	}{
+		{"Top Gainers", r.TopGainers},
+		{"Top Losers", r.TopLosers},
+		{"Most Actively Traded", r.MostActivelyTraded},
+	} {
+		sb.WriteString(list.name + ":\n")
+		for _, m := range list.entries {
+			sb.WriteString(fmt.Sprintf("  %-6s %10.4f %+10.4f %+8.2f%% %d\n", m.Ticker, m.Price, m.ChangeAmount, float64(m.ChangePercentage), m.Volume))
+		}
+	}
+	return sb.String()
+}
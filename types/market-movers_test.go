@@ -0,0 +1,65 @@
+package types
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestTopGainersLosersResponse_Unmarshal(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/top_gainers_losers.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp TopGainersLosersResponse
+	if err := UnmarshalLenient(data, &resp); err != nil {
+		t.Fatalf("UnmarshalLenient returned error: %v", err)
+	}
+
+	if len(resp.TopGainers) != 2 || len(resp.TopLosers) != 1 || len(resp.MostActivelyTraded) != 1 {
+		t.Fatalf("unexpected list sizes: %d, %d, %d", len(resp.TopGainers), len(resp.TopLosers), len(resp.MostActivelyTraded))
+	}
+
+	gainer := resp.TopGainers[0]
+	if gainer.Ticker != "ABCW" || gainer.Price != 4.12 || gainer.ChangeAmount != 2.62 || gainer.ChangePercentage != 174.6667 || gainer.Volume != 98765432 {
+		t.Fatalf("unexpected gainer: %+v", gainer)
+	}
+	if loser := resp.TopLosers[0]; loser.ChangePercentage != -70 || loser.ChangeAmount != -2.45 {
+		t.Fatalf("unexpected loser: %+v", loser)
+	}
+
+	if _, err := time.LoadLocation("US/Eastern"); err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	updated, err := resp.LastUpdatedAt()
+	if err != nil {
+		t.Fatalf("LastUpdatedAt returned error: %v", err)
+	}
+	if !updated.Equal(time.Date(2024, 12, 31, 21, 15, 59, 0, time.UTC)) {
+		t.Fatalf("unexpected LastUpdatedAt: %v", updated)
+	}
+}
+
+func TestPercent_UnmarshalJSON(t *testing.T) {
+	cases := map[string]Percent{
+		`"1.5%"`:  1.5,
+		`"-0.25"`: -0.25,
+		`2.75`:    2.75,
+		`"n/a"`:   0,
+	}
+	for input, want := range cases {
+		var p Percent
+		if err := p.UnmarshalJSON([]byte(input)); err != nil {
+			t.Fatalf("UnmarshalJSON(%s) returned error: %v", input, err)
+		}
+		if p != want {
+			t.Fatalf("UnmarshalJSON(%s) = %v, want %v", input, p, want)
+		}
+	}
+
+	var p Percent
+	if err := p.UnmarshalJSON([]byte(`"abc%"`)); err == nil {
+		t.Fatal("expected error for non-numeric percentage")
+	}
+}
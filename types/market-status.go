@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MarketState is the current_status of a market.
+type MarketState string
+
+const (
+	MarketOpen   MarketState = "open"
+	MarketClosed MarketState = "closed"
+)
+
+// MarketStatusResponse models the MARKET_STATUS response.
+type MarketStatusResponse struct {
+	Endpoint string         `json:"endpoint"`
+	Markets  []MarketStatus `json:"markets"`
+}
+
+// MarketStatus describes one market's trading hours and current state.
+// LocalOpen and LocalClose are HH:MM in the region's local time.
+type MarketStatus struct {
+	MarketType       string      `json:"market_type"`
+	Region           string      `json:"region"`
+	PrimaryExchanges string      `json:"primary_exchanges"`
+	LocalOpen        string      `json:"local_open"`
+	LocalClose       string      `json:"local_close"`
+	CurrentStatus    MarketState `json:"current_status"`
+	Notes            string      `json:"notes"`
+}
+
+// regionTimeZones maps MARKET_STATUS regions to IANA time zones.
+var regionTimeZones = map[string]string{
+	"united states":  "America/New_York",
+	"canada":         "America/Toronto",
+	"united kingdom": "Europe/London",
+	"germany":        "Europe/Berlin",
+	"france":         "Europe/Paris",
+	"spain":          "Europe/Madrid",
+	"portugal":       "Europe/Lisbon",
+	"japan":          "Asia/Tokyo",
+	"india":          "Asia/Kolkata",
+	"mainland china": "Asia/Shanghai",
+	"hong kong":      "Asia/Hong_Kong",
+	"brazil":         "America/Sao_Paulo",
+	"mexico":         "America/Mexico_City",
+	"south africa":   "Africa/Johannesburg",
+	"global":         "UTC",
+}
+
+// RegionTimeZone returns the IANA time zone used for a MARKET_STATUS region.
+func RegionTimeZone(region string) (string, bool) {
+	tz, ok := regionTimeZones[strings.ToLower(strings.TrimSpace(region))]
+	return tz, ok
+}
+
+// Market returns the market of marketType (e.g. "Equity") in region, matching
+// both case-insensitively.
+func (r MarketStatusResponse) Market(marketType, region string) (MarketStatus, bool) {
+	for _, m := range r.Markets {
+		if strings.EqualFold(m.MarketType, marketType) && strings.EqualFold(m.Region, region) {
+			return m, true
+		}
+	}
+	return MarketStatus{}, false
+}
+
+// IsOpen reports whether the equity market in region is open at t. See
+// MarketStatus.IsOpenAt.
+func (r MarketStatusResponse) IsOpen(region string, t time.Time) (bool, error) {
+	m, ok := r.Market("Equity", region)
+	if !ok {
+		return false, fmt.Errorf("market status: no equity market for region %q", region)
+	}
+	return m.IsOpenAt(t)
+}
+
+// Exchanges splits PrimaryExchanges into individual exchange names.
+func (m MarketStatus) Exchanges() []string {
+	var out []string
+	for _, e := range strings.Split(m.PrimaryExchanges, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// IsOpenAt reports whether t falls within the market's regular hours on a
+// weekday in the region's local time. Cryptocurrency markets are always open.
+// Exchange holidays and early closes are not known to the API response, so
+// CurrentStatus should be preferred for the time the response was fetched.
+func (m MarketStatus) IsOpenAt(t time.Time) (bool, error) {
+	if strings.EqualFold(m.MarketType, "Cryptocurrency") {
+		return true, nil
+	}
+
+	tz, ok := RegionTimeZone(m.Region)
+	if !ok {
+		return false, fmt.Errorf("market status: unknown time zone for region %q", m.Region)
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return false, err
+	}
+
+	open, err := parseClock(m.LocalOpen)
+	if err != nil {
+		return false, fmt.Errorf("market status: local_open: %w", err)
+	}
+	closing, err := parseClock(m.LocalClose)
+	if err != nil {
+		return false, fmt.Errorf("market status: local_close: %w", err)
+	}
+
+	local := t.In(loc)
+	if local.Weekday() == time.Saturday || local.Weekday() == time.Sunday {
+		return false, nil
+	}
+
+	now := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute + time.Duration(local.Second())*time.Second
+	if closing <= open {
+		// Sessions that cross midnight, or 00:00-00:00 for round-the-clock markets.
+		return now >= open || now < closing || closing == open, nil
+	}
+	return now >= open && now < closing, nil
+}
+
+// parseClock parses an HH:MM time of day as an offset from midnight.
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func loadMarketStatus(t *testing.T) MarketStatusResponse {
+	t.Helper()
+	data, err := os.ReadFile("../models/testdata/market_status.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp MarketStatusResponse
+	if err := UnmarshalLenient(data, &resp); err != nil {
+		t.Fatalf("UnmarshalLenient returned error: %v", err)
+	}
+	return resp
+}
+
+func TestMarketStatusResponse_Unmarshal(t *testing.T) {
+	resp := loadMarketStatus(t)
+
+	us, ok := resp.Market("equity", "united states")
+	if !ok {
+		t.Fatal("expected a US equity market")
+	}
+	if us.LocalOpen != "09:30" || us.LocalClose != "16:15" || us.CurrentStatus != MarketClosed {
+		t.Fatalf("unexpected US market: %+v", us)
+	}
+	if got := us.Exchanges(); len(got) != 4 || got[0] != "NASDAQ" || got[3] != "BATS" {
+		t.Fatalf("unexpected exchanges: %v", got)
+	}
+	if _, ok := resp.Market("Equity", "Atlantis"); ok {
+		t.Fatal("expected no market for an unknown region")
+	}
+}
+
+func TestMarketStatusResponse_IsOpen(t *testing.T) {
+	if _, err := time.LoadLocation("America/New_York"); err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	resp := loadMarketStatus(t)
+
+	cases := []struct {
+		name   string
+		region string
+		at     time.Time
+		want   bool
+	}{
+		// 2024-07-10 is a Wednesday; New York is UTC-4 in July.
+		{"US before open", "United States", time.Date(2024, 7, 10, 13, 29, 0, 0, time.UTC), false},
+		{"US at open", "United States", time.Date(2024, 7, 10, 13, 30, 0, 0, time.UTC), true},
+		{"US before close", "United States", time.Date(2024, 7, 10, 20, 14, 0, 0, time.UTC), true},
+		{"US at close", "United States", time.Date(2024, 7, 10, 20, 15, 0, 0, time.UTC), false},
+		{"US Saturday", "United States", time.Date(2024, 7, 13, 15, 0, 0, 0, time.UTC), false},
+		// Tokyo is UTC+9, so 01:00 UTC Wednesday is 10:00 local.
+		{"Japan morning", "Japan", time.Date(2024, 7, 10, 1, 0, 0, 0, time.UTC), true},
+		{"Japan evening", "Japan", time.Date(2024, 7, 10, 9, 0, 0, 0, time.UTC), false},
+	}
+	for _, tc := range cases {
+		got, err := resp.IsOpen(tc.region, tc.at)
+		if err != nil {
+			t.Fatalf("%s: IsOpen returned error: %v", tc.name, err)
+		}
+		if got != tc.want {
+			t.Fatalf("%s: IsOpen = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	if _, err := resp.IsOpen("Atlantis", time.Now()); err == nil {
+		t.Fatal("expected error for an unknown region")
+	}
+
+	crypto, _ := resp.Market("Cryptocurrency", "Global")
+	if open, err := crypto.IsOpenAt(time.Date(2024, 7, 13, 3, 0, 0, 0, time.UTC)); err != nil || !open {
+		t.Fatalf("expected crypto to be open on Saturday, got %v, %v", open, err)
+	}
+}
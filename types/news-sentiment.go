@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NewsTimeFormat is the YYYYMMDDTHHMM layout NEWS_SENTIMENT uses for the
+// time_from and time_to parameters.
+const NewsTimeFormat = "20060102T1504"
+
+// newsPublishedFormat is the layout of NewsArticle.TimePublished.
+const newsPublishedFormat = "20060102T150405"
+
+// NewsTopic filters NEWS_SENTIMENT results by subject.
+type NewsTopic string
+
+const (
+	TopicBlockchain             NewsTopic = "blockchain"
+	TopicEarnings               NewsTopic = "earnings"
+	TopicIPO                    NewsTopic = "ipo"
+	TopicMergersAndAcquisitions NewsTopic = "mergers_and_acquisitions"
+	TopicFinancialMarkets       NewsTopic = "financial_markets"
+	TopicEconomyFiscal          NewsTopic = "economy_fiscal"
+	TopicEconomyMonetary        NewsTopic = "economy_monetary"
+	TopicEconomyMacro           NewsTopic = "economy_macro"
+	TopicEnergyTransportation   NewsTopic = "energy_transportation"
+	TopicFinance                NewsTopic = "finance"
+	TopicLifeSciences           NewsTopic = "life_sciences"
+	TopicManufacturing          NewsTopic = "manufacturing"
+	TopicRealEstate             NewsTopic = "real_estate"
+	TopicRetailWholesale        NewsTopic = "retail_wholesale"
+	TopicTechnology             NewsTopic = "technology"
+)
+
+// NewsSort orders NEWS_SENTIMENT results.
+type NewsSort string
+
+const (
+	NewsSortLatest    NewsSort = "LATEST"
+	NewsSortEarliest  NewsSort = "EARLIEST"
+	NewsSortRelevance NewsSort = "RELEVANCE"
+)
+
+// NewsSentimentParams defines parameters for the NEWS_SENTIMENT endpoint. All
+// fields are optional. Tickers accepts stock symbols as well as CRYPTO: and
+// FOREX: prefixed symbols; articles must mention every ticker given. TimeFrom
+// and TimeTo are converted to UTC and sent at minute precision. Limit ranges
+// from 1 to 1000; zero uses the API default of 50.
+type NewsSentimentParams struct {
+	Tickers  []string
+	Topics   []NewsTopic
+	TimeFrom time.Time
+	TimeTo   time.Time
+	Sort     NewsSort
+	Limit    int
+}
+
+// NewsSentimentResponse models the NEWS_SENTIMENT response.
+type NewsSentimentResponse struct {
+	Items                    int           `json:"items,string"`
+	SentimentScoreDefinition string        `json:"sentiment_score_definition"`
+	RelevanceScoreDefinition string        `json:"relevance_score_definition"`
+	Feed                     []NewsArticle `json:"feed"`
+}
+
+// NewsArticle is a single feed item with its overall and per-ticker sentiment.
+type NewsArticle struct {
+	Title                 string            `json:"title"`
+	URL                   string            `json:"url"`
+	TimePublished         string            `json:"time_published"`
+	Authors               []string          `json:"authors"`
+	Summary               string            `json:"summary"`
+	BannerImage           string            `json:"banner_image"`
+	Source                string            `json:"source"`
+	CategoryWithinSource  string            `json:"category_within_source"`
+	SourceDomain          string            `json:"source_domain"`
+	Topics                []TopicRelevance  `json:"topics"`
+	OverallSentimentScore float64           `json:"overall_sentiment_score"`
+	OverallSentimentLabel string            `json:"overall_sentiment_label"`
+	TickerSentiment       []TickerSentiment `json:"ticker_sentiment"`
+}
+
+// TopicRelevance scores how relevant an article is to a topic, from 0 to 1.
+type TopicRelevance struct {
+	Topic          string  `json:"topic"`
+	RelevanceScore float64 `json:"relevance_score,string"`
+}
+
+// TickerSentiment is the sentiment of an article towards a single ticker.
+type TickerSentiment struct {
+	Ticker         string  `json:"ticker"`
+	RelevanceScore float64 `json:"relevance_score,string"`
+	SentimentScore float64 `json:"ticker_sentiment_score,string"`
+	SentimentLabel string  `json:"ticker_sentiment_label"`
+}
+
+// PublishedAt parses TimePublished. The timestamp carries no zone, so the
+// result is in UTC.
+func (a NewsArticle) PublishedAt() (time.Time, error) {
+	return time.Parse(newsPublishedFormat, a.TimePublished)
+}
+
+// SentimentFor returns the article's sentiment towards ticker.
+func (a NewsArticle) SentimentFor(ticker string) (TickerSentiment, bool) {
+	for _, ts := range a.TickerSentiment {
+		if strings.EqualFold(ts.Ticker, ticker) {
+			return ts, true
+		}
+	}
+	return TickerSentiment{}, false
+}
+
+// String renders a concise summary.
+func (r NewsSentimentResponse) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("News Sentiment: %d items\n", r.Items))
+	for _, a := range r.Feed {
+		sb.WriteString(fmt.Sprintf("  %s [%s %.3f] %s\n", a.TimePublished, a.OverallSentimentLabel, a.OverallSentimentScore, a.Title))
+	}
+	return sb.String()
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestNewsSentimentResponse_Unmarshal(t *testing.T) {
+	data, err := os.ReadFile("../models/testdata/news_sentiment_AAPL.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp NewsSentimentResponse
+	if err := UnmarshalLenient(data, &resp); err != nil {
+		t.Fatalf("UnmarshalLenient returned error: %v", err)
+	}
+
+	if resp.Items != 2 || len(resp.Feed) != 2 {
+		t.Fatalf("unexpected item count: %d, %d", resp.Items, len(resp.Feed))
+	}
+
+	article := resp.Feed[0]
+	if article.Source != "Example News" || article.SourceDomain != "www.example.com" || len(article.Authors) != 1 {
+		t.Fatalf("unexpected source metadata: %+v", article)
+	}
+	if article.OverallSentimentScore != 0.284512 || article.OverallSentimentLabel != "Somewhat-Bullish" {
+		t.Fatalf("unexpected overall sentiment: %v %s", article.OverallSentimentScore, article.OverallSentimentLabel)
+	}
+	if len(article.Topics) != 2 || article.Topics[1].RelevanceScore != 0.5 {
+		t.Fatalf("unexpected topics: %+v", article.Topics)
+	}
+
+	published, err := article.PublishedAt()
+	if err != nil || !published.Equal(time.Date(2024, 10, 30, 14, 15, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected PublishedAt: %v, %v", published, err)
+	}
+
+	intc, ok := article.SentimentFor("intc")
+	if !ok || intc.SentimentScore != -0.201234 || intc.SentimentLabel != "Somewhat-Bearish" || intc.RelevanceScore != 0.123456 {
+		t.Fatalf("unexpected INTC sentiment: %+v, %v", intc, ok)
+	}
+	if _, ok := article.SentimentFor("MSFT"); ok {
+		t.Fatal("expected no MSFT sentiment")
+	}
+}
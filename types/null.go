@@ -0,0 +1,63 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NullFloat64 is a float64 that may be missing. Alpha Vantage reports missing
+// numbers as "None" (and occasionally "-", "." or "n/a"); those decode with
+// Valid set to false, so a reported zero can be told apart from no value.
+type NullFloat64 struct {
+	Float64 float64
+	Valid   bool
+}
+
+// UnmarshalJSON accepts a JSON number, a numeric string, null or a missing-value placeholder.
+func (n *NullFloat64) UnmarshalJSON(data []byte) error {
+	*n = NullFloat64{}
+
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+
+	s = strings.TrimSpace(s)
+	switch strings.ToLower(s) {
+	case "", "none", "-", ".", "n/a", "na":
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("invalid number %q: %w", s, err)
+	}
+	n.Float64, n.Valid = f, true
+	return nil
+}
+
+// MarshalJSON encodes n as a number, or null when it is not valid.
+func (n NullFloat64) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Float64)
+}
+
+// String formats the value, or "None" when it is not valid.
+func (n NullFloat64) String() string {
+	if !n.Valid {
+		return "None"
+	}
+	return strconv.FormatFloat(n.Float64, 'f', -1, 64)
+}
@@ -0,0 +1,192 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RealtimeOptionsParams defines parameters for the REALTIME_OPTIONS endpoint.
+type RealtimeOptionsParams struct {
+	Symbol string
+	// Contract optionally limits the response to a single contract ID, e.g. IBM270115C00390000.
+	Contract string
+	// RequireGreeks requests implied volatility and greeks in the response.
+	RequireGreeks bool
+}
+
+// HistoricalOptionsParams defines parameters for the HISTORICAL_OPTIONS endpoint.
+type HistoricalOptionsParams struct {
+	Symbol string
+	// Date selects the trading day; the zero value returns the previous trading session.
+	Date time.Time
+}
+
+// OptionType distinguishes calls from puts.
+type OptionType string
+
+const (
+	OptionCall OptionType = "call"
+	OptionPut  OptionType = "put"
+)
+
+// OptionsChainResponse models the REALTIME_OPTIONS and HISTORICAL_OPTIONS responses.
+type OptionsChainResponse struct {
+	Endpoint string      `json:"endpoint"`
+	Message  string      `json:"message"`
+	Data     OptionChain `json:"data"`
+}
+
+// OptionContract is a single option contract quote. Quote prices, implied
+// volatility and greeks are NullFloat64 because the API leaves them blank for
+// contracts that have not traded and for realtime quotes requested without
+// RequireGreeks.
+type OptionContract struct {
+	ContractID        string      `json:"contractID"`
+	Symbol            string      `json:"symbol"`
+	Expiration        string      `json:"expiration"`
+	Strike            float64     `json:"strike,string"`
+	Type              OptionType  `json:"type"`
+	Last              NullFloat64 `json:"last"`
+	Mark              NullFloat64 `json:"mark"`
+	Bid               NullFloat64 `json:"bid"`
+	BidSize           int64       `json:"bid_size,string"`
+	Ask               NullFloat64 `json:"ask"`
+	AskSize           int64       `json:"ask_size,string"`
+	Volume            int64       `json:"volume,string"`
+	OpenInterest      int64       `json:"open_interest,string"`
+	Date              string      `json:"date"`
+	ImpliedVolatility NullFloat64 `json:"implied_volatility"`
+	Delta             NullFloat64 `json:"delta"`
+	Gamma             NullFloat64 `json:"gamma"`
+	Theta             NullFloat64 `json:"theta"`
+	Vega              NullFloat64 `json:"vega"`
+	Rho               NullFloat64 `json:"rho"`
+}
+
+// Moneyness classifies a contract's strike relative to the underlying price.
+type Moneyness int
+
+const (
+	InTheMoney Moneyness = iota + 1
+	AtTheMoney
+	OutOfTheMoney
+)
+
+func (m Moneyness) String() string {
+	switch m {
+	case InTheMoney:
+		return "ITM"
+	case AtTheMoney:
+		return "ATM"
+	case OutOfTheMoney:
+		return "OTM"
+	default:
+		return "unknown"
+	}
+}
+
+// ExpirationDate parses Expiration as a date.
+func (c OptionContract) ExpirationDate() (time.Time, error) {
+	return time.Parse("2006-01-02", c.Expiration)
+}
+
+// Moneyness classifies the contract against spot. Strikes within atmTolerance
+// (a fraction of spot, e.g. 0.01 for 1%) are at the money.
+func (c OptionContract) Moneyness(spot, atmTolerance float64) Moneyness {
+	if spot > 0 && math.Abs(c.Strike-spot)/spot <= atmTolerance {
+		return AtTheMoney
+	}
+
+	itm := c.Strike < spot
+	if c.Type == OptionPut {
+		itm = c.Strike > spot
+	}
+	if itm {
+		return InTheMoney
+	}
+	return OutOfTheMoney
+}
+
+// OptionChain is a list of option contracts with filtering helpers. Filters
+// return new chains and never modify the receiver.
+type OptionChain []OptionContract
+
+// Calls returns the call contracts in the chain.
+func (ch OptionChain) Calls() OptionChain {
+	return ch.filter(func(c OptionContract) bool { return c.Type == OptionCall })
+}
+
+// Puts returns the put contracts in the chain.
+func (ch OptionChain) Puts() OptionChain {
+	return ch.filter(func(c OptionContract) bool { return c.Type == OptionPut })
+}
+
+// Expirations returns the distinct expiration dates in ascending order.
+func (ch OptionChain) Expirations() []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, c := range ch {
+		if !seen[c.Expiration] {
+			seen[c.Expiration] = true
+			out = append(out, c.Expiration)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// ByExpiration returns contracts expiring on any of the given YYYY-MM-DD dates.
+func (ch OptionChain) ByExpiration(expirations ...string) OptionChain {
+	want := make(map[string]bool, len(expirations))
+	for _, e := range expirations {
+		want[strings.TrimSpace(e)] = true
+	}
+	return ch.filter(func(c OptionContract) bool { return want[c.Expiration] })
+}
+
+// ByExpirationRange returns contracts expiring between from and to, inclusive.
+func (ch OptionChain) ByExpirationRange(from, to time.Time) OptionChain {
+	lo, hi := from.Format("2006-01-02"), to.Format("2006-01-02")
+	return ch.filter(func(c OptionContract) bool { return c.Expiration >= lo && c.Expiration <= hi })
+}
+
+// ByMoneyness returns contracts whose moneyness against spot is m.
+// See OptionContract.Moneyness for atmTolerance.
+func (ch OptionChain) ByMoneyness(spot, atmTolerance float64, m Moneyness) OptionChain {
+	return ch.filter(func(c OptionContract) bool { return c.Moneyness(spot, atmTolerance) == m })
+}
+
+func (ch OptionChain) filter(keep func(OptionContract) bool) OptionChain {
+	var out OptionChain
+	for _, c := range ch {
+		if keep(c) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// String renders a concise summary of the option chain.
+func (r OptionsChainResponse) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s (%d contracts)\n", r.Endpoint, len(r.Data)))
+
+	limit := len(r.Data)
+	if limit > 10 {
+		limit = 10
+	}
+
+	for i := 0; i < limit; i++ {
+		c := r.Data[i]
+		sb.WriteString(fmt.Sprintf("%s %s %s %.2f bid %s ask %s iv %s\n", c.ContractID, c.Expiration, c.Type, c.Strike, c.Bid, c.Ask, c.ImpliedVolatility))
+	}
+
+	if len(r.Data) > limit {
+		sb.WriteString(fmt.Sprintf("...and %d more\n", len(r.Data)-limit))
+	}
+
+	return sb.String()
+}
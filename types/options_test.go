@@ -0,0 +1,93 @@
+package types
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func loadHistoricalOptions(t *testing.T) OptionsChainResponse {
+	t.Helper()
+	data, err := os.ReadFile("../models/testdata/historical_options_IBM.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var resp OptionsChainResponse
+	if err := UnmarshalLenient(data, &resp); err != nil {
+		t.Fatalf("UnmarshalLenient returned error: %v", err)
+	}
+	return resp
+}
+
+func TestOptionsChainResponse_Unmarshal(t *testing.T) {
+	resp := loadHistoricalOptions(t)
+
+	if resp.Endpoint != "Historical Options" || len(resp.Data) != 5 {
+		t.Fatalf("unexpected response: %s, %d contracts", resp.Endpoint, len(resp.Data))
+	}
+
+	first := resp.Data[0]
+	if first.ContractID != "IBM250117C00200000" || first.Type != OptionCall || first.Strike != 200 {
+		t.Fatalf("unexpected first contract: %+v", first)
+	}
+	if first.Bid.Float64 != 22.60 || first.AskSize != 15 || first.OpenInterest != 1523 {
+		t.Fatalf("unexpected quote fields: %+v", first)
+	}
+	if first.ImpliedVolatility.Float64 != 0.23456 || first.Delta.Float64 != 0.91234 || first.Theta.Float64 != -0.08123 {
+		t.Fatalf("unexpected greeks: %+v", first)
+	}
+	if resp.Data[3].ImpliedVolatility.Valid {
+		t.Fatalf("expected n/a implied volatility to be invalid, got %v", resp.Data[3].ImpliedVolatility)
+	}
+}
+
+func TestOptionsChainResponse_UnmarshalEmptyValues(t *testing.T) {
+	c := loadHistoricalOptions(t).Data[4]
+
+	if c.ContractID != "IBM250221C00260000" || c.Strike != 260 || c.Volume != 0 {
+		t.Fatalf("unexpected contract: %+v", c)
+	}
+	for name, v := range map[string]NullFloat64{
+		"last": c.Last, "mark": c.Mark, "bid": c.Bid, "ask": c.Ask,
+		"implied_volatility": c.ImpliedVolatility, "delta": c.Delta, "gamma": c.Gamma,
+		"theta": c.Theta, "vega": c.Vega, "rho": c.Rho,
+	} {
+		if v.Valid {
+			t.Fatalf("expected empty %s to be invalid, got %v", name, v)
+		}
+	}
+}
+
+func TestOptionChain_Filters(t *testing.T) {
+	chain := loadHistoricalOptions(t).Data
+
+	if got := chain.Expirations(); len(got) != 2 || got[0] != "2025-01-17" || got[1] != "2025-02-21" {
+		t.Fatalf("unexpected expirations: %v", got)
+	}
+	if got := chain.Calls(); len(got) != 3 {
+		t.Fatalf("expected 3 calls, got %d", len(got))
+	}
+	if got := chain.ByExpiration("2025-01-17").Puts(); len(got) != 1 || got[0].ContractID != "IBM250117P00200000" {
+		t.Fatalf("unexpected January puts: %+v", got)
+	}
+
+	from := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	if got := chain.ByExpirationRange(from, to); len(got) != 2 || got[0].Expiration != "2025-02-21" || got[1].Expiration != "2025-02-21" {
+		t.Fatalf("unexpected range filter result: %+v", got)
+	}
+
+	const spot = 224.0
+	if got := chain.ByMoneyness(spot, 0.01, AtTheMoney); len(got) != 1 || got[0].Strike != 225 {
+		t.Fatalf("unexpected ATM contracts: %+v", got)
+	}
+
+	itm := chain.ByMoneyness(spot, 0.01, InTheMoney)
+	if len(itm) != 2 || itm[0].ContractID != "IBM250117C00200000" || itm[1].ContractID != "IBM250221P00240000" {
+		t.Fatalf("unexpected ITM contracts: %+v", itm)
+	}
+	if got := chain.ByMoneyness(spot, 0.01, OutOfTheMoney); len(got) != 2 || got[0].Type != OptionPut || got[1].Type != OptionCall {
+		t.Fatalf("unexpected OTM contracts: %+v", got)
+	}
+}
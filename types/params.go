@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// OutputSize selects how many data points a series endpoint returns.
+type OutputSize string
+
+const (
+	// OutputSizeCompact returns the latest 100 data points (the API default).
+	OutputSizeCompact OutputSize = "compact"
+	// OutputSizeFull returns the full available history.
+	OutputSizeFull OutputSize = "full"
+)
+
+// Valid reports whether o is empty or a known output size.
+func (o OutputSize) Valid() bool {
+	return o == "" || o == OutputSizeCompact || o == OutputSizeFull
+}
+
+// CheckOutputSize normalizes size and verifies it is compact or full. An empty
+// size is returned unchanged so the API default applies.
+func CheckOutputSize(function string, size OutputSize) (OutputSize, error) {
+	size = OutputSize(strings.ToLower(strings.TrimSpace(string(size))))
+	if !size.Valid() {
+		return "", NewParameterError(function, "outputsize", "outputsize must be compact or full")
+	}
+	return size, nil
+}
+
+// Interval is the bar interval for time series, crypto, forex and technical
+// indicator endpoints.
+type Interval string
+
+const (
+	Interval1Min    Interval = "1min"
+	Interval5Min    Interval = "5min"
+	Interval15Min   Interval = "15min"
+	Interval30Min   Interval = "30min"
+	Interval60Min   Interval = "60min"
+	IntervalDaily   Interval = "daily"
+	IntervalWeekly  Interval = "weekly"
+	IntervalMonthly Interval = "monthly"
+)
+
+// Intraday reports whether i is one of the minute intervals.
+func (i Interval) Intraday() bool {
+	switch i {
+	case Interval1Min, Interval5Min, Interval15Min, Interval30Min, Interval60Min:
+		return true
+	}
+	return false
+}
+
+// Valid reports whether i is a known interval.
+func (i Interval) Valid() bool {
+	return i.Intraday() || i == IntervalDaily || i == IntervalWeekly || i == IntervalMonthly
+}
+
+// CheckInterval normalizes interval and verifies that it is set and known.
+// When intraday is true only the minute intervals are accepted.
+func CheckInterval(function string, interval Interval, intraday bool) (Interval, error) {
+	interval = Interval(strings.ToLower(strings.TrimSpace(string(interval))))
+	switch {
+	case interval == "":
+		return "", NewParameterError(function, "interval", "interval is required")
+	case intraday && !interval.Intraday():
+		return "", NewParameterError(function, "interval", "interval must be one of 1min, 5min, 15min, 30min, 60min")
+	case !interval.Valid():
+		return "", NewParameterError(function, "interval", "interval must be one of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly")
+	}
+	return interval, nil
+}
+
+// YearMonth selects a calendar month of intraday history, sent as YYYY-MM.
+// The zero value means the most recent data.
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// NewYearMonth returns the YearMonth for year and month.
+func NewYearMonth(year int, month time.Month) YearMonth {
+	return YearMonth{Year: year, Month: month}
+}
+
+// ParseYearMonth parses a month in YYYY-MM form, e.g. 2009-01.
+func ParseYearMonth(s string) (YearMonth, error) {
+	t, err := time.Parse("2006-01", s)
+	if err != nil {
+		return YearMonth{}, fmt.Errorf("invalid month %q: expected YYYY-MM", s)
+	}
+	return YearMonth{Year: t.Year(), Month: t.Month()}, nil
+}
+
+// IsZero reports whether ym is the zero value.
+func (ym YearMonth) IsZero() bool {
+	return ym == YearMonth{}
+}
+
+// CheckMonth verifies that month is zero or a valid YearMonth.
+func CheckMonth(function string, month YearMonth) error {
+	if !month.Valid() {
+		return NewParameterError(function, "month", "month must be 2000-01 or later")
+	}
+	return nil
+}
+
+// Valid reports whether ym is zero or a real month no earlier than 2000-01,
+// the start of Alpha Vantage's intraday history.
+func (ym YearMonth) Valid() bool {
+	return ym.IsZero() || (ym.Year >= 2000 && ym.Month >= time.January && ym.Month <= time.December)
+}
+
+// String formats ym as YYYY-MM, or "" for the zero value.
+func (ym YearMonth) String() string {
+	if ym.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d", ym.Year, int(ym.Month))
+}
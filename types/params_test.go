@@ -0,0 +1,75 @@
+package types
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestYearMonth_ParseAndString(t *testing.T) {
+	ym, err := ParseYearMonth("2009-01")
+	if err != nil {
+		t.Fatalf("ParseYearMonth returned error: %v", err)
+	}
+	if ym != NewYearMonth(2009, time.January) || ym.String() != "2009-01" || !ym.Valid() {
+		t.Fatalf("unexpected year-month: %+v (%s)", ym, ym)
+	}
+
+	if _, err := ParseYearMonth("2009-13"); err == nil {
+		t.Fatal("expected error for month 13")
+	}
+	if (YearMonth{}).String() != "" || !(YearMonth{}).Valid() {
+		t.Fatal("expected zero YearMonth to be valid and format as empty")
+	}
+	if NewYearMonth(1999, time.December).Valid() || NewYearMonth(2020, 0).Valid() {
+		t.Fatal("expected months before 2000-01 and month 0 to be invalid")
+	}
+}
+
+func TestCheckInterval(t *testing.T) {
+	got, err := CheckInterval("TIME_SERIES_INTRADAY", " 5MIN ", true)
+	if err != nil || got != Interval5Min {
+		t.Fatalf("expected normalized 5min, got %q, %v", got, err)
+	}
+
+	cases := []struct {
+		interval Interval
+		intraday bool
+	}{
+		{"", false},
+		{"2min", false},
+		{IntervalDaily, true},
+	}
+	for _, tc := range cases {
+		_, err := CheckInterval("SMA", tc.interval, tc.intraday)
+		var paramErr *ParameterError
+		if !errors.As(err, &paramErr) || paramErr.Parameter != "interval" {
+			t.Fatalf("CheckInterval(%q, %v): expected interval ParameterError, got %v", tc.interval, tc.intraday, err)
+		}
+	}
+
+	if got, err := CheckInterval("SMA", IntervalWeekly, false); err != nil || got != IntervalWeekly {
+		t.Fatalf("expected weekly to be accepted, got %q, %v", got, err)
+	}
+}
+
+func TestCheckOutputSize(t *testing.T) {
+	if got, err := CheckOutputSize("FX_DAILY", ""); err != nil || got != "" {
+		t.Fatalf("expected empty output size to pass through, got %q, %v", got, err)
+	}
+	if got, err := CheckOutputSize("FX_DAILY", "Full"); err != nil || got != OutputSizeFull {
+		t.Fatalf("expected normalized full, got %q, %v", got, err)
+	}
+	if _, err := CheckOutputSize("FX_DAILY", "everything"); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter, got %v", err)
+	}
+}
+
+func TestMAType_Valid(t *testing.T) {
+	if !MATypeSMA.Valid() || !MATypeMAMA.Valid() {
+		t.Fatal("expected SMA and MAMA to be valid moving average types")
+	}
+	if MAType(-1).Valid() || MAType(9).Valid() {
+		t.Fatal("expected out of range moving average types to be invalid")
+	}
+}
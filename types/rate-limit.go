@@ -0,0 +1,42 @@
+package types
+
+import "errors"
+
+// ErrRateLimitExceeded is returned when the client-side rate limiter is configured
+// with RateLimitFailFast and no request quota is currently available.
+var ErrRateLimitExceeded = errors.New("alpha vantage: client rate limit exceeded")
+
+// RateLimitPolicy controls what the client does when its local request quota is exhausted.
+type RateLimitPolicy int
+
+const (
+	// RateLimitBlock waits until quota is available or the request context is done.
+	RateLimitBlock RateLimitPolicy = iota
+	// RateLimitFailFast returns ErrRateLimitExceeded immediately.
+	RateLimitFailFast
+)
+
+// RateLimit configures the client-side token-bucket limiter. A zero value for
+// either quota disables that quota; a zero RateLimit disables limiting entirely.
+type RateLimit struct {
+	RequestsPerMinute int
+	RequestsPerDay    int
+	Policy            RateLimitPolicy
+}
+
+// Enabled reports whether any quota is configured.
+func (r RateLimit) Enabled() bool {
+	return r.RequestsPerMinute > 0 || r.RequestsPerDay > 0
+}
+
+// FreeTierRateLimit returns the quotas Alpha Vantage applies to free API keys
+// (5 requests per minute, 25 requests per day) with blocking behavior.
+func FreeTierRateLimit() RateLimit {
+	return RateLimit{RequestsPerMinute: 5, RequestsPerDay: 25, Policy: RateLimitBlock}
+}
+
+// PremiumRateLimit returns a per-minute quota for premium API keys, which have
+// no daily cap.
+func PremiumRateLimit(requestsPerMinute int) RateLimit {
+	return RateLimit{RequestsPerMinute: requestsPerMinute, Policy: RateLimitBlock}
+}
@@ -0,0 +1,61 @@
+package types
+
+import "time"
+
+// RetryClass is a bit set of error classes the client may retry.
+type RetryClass int
+
+const (
+	// RetryThrottle retries Alpha Vantage rate-limit notices ("Note", or an
+	// "Information" message about request frequency).
+	RetryThrottle RetryClass = 1 << iota
+	// RetryServerError retries HTTP 5xx and 429 responses.
+	RetryServerError
+	// RetryTimeout retries HTTP client timeouts.
+	RetryTimeout
+	// RetryNetwork retries other transport failures such as connection resets.
+	RetryNetwork
+)
+
+// DefaultRetryClasses are used when RetryPolicy.RetryOn is zero.
+const DefaultRetryClasses = RetryThrottle | RetryServerError | RetryTimeout
+
+// RetryPolicy configures automatic retries in the client. "Error Message"
+// responses (for example an invalid symbol) and premium-endpoint notices are
+// never retried. A zero RetryPolicy performs a single attempt.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. Zero means no cap.
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after each attempt. Values below 1 are treated as 2.
+	Multiplier float64
+	// Jitter randomly shortens each delay by up to this fraction (0 to 1).
+	Jitter float64
+	// RetryOn selects the retryable error classes. Zero means DefaultRetryClasses.
+	RetryOn RetryClass
+}
+
+// DefaultRetryPolicy returns a policy of up to 4 attempts with exponential
+// backoff starting at 2s, capped at 30s, with 20% jitter.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 2 * time.Second,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryOn:        DefaultRetryClasses,
+	}
+}
+
+// Retries reports whether the policy retries errors of class c.
+func (p RetryPolicy) Retries(c RetryClass) bool {
+	on := p.RetryOn
+	if on == 0 {
+		on = DefaultRetryClasses
+	}
+	return on&c != 0
+}
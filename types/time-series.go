@@ -34,10 +34,39 @@ type TimeSeriesMetaData struct {
 
 // TimeSeriesParams represents the parameters for querying time series data
 type TimeSeriesParams struct {
-	Symbol     string
-	Interval   string
-	Month      interface{}
-	OutputSize interface{}
+	Symbol string
+	// Interval is required for intraday series and must be a minute interval.
+	Interval Interval
+	// Month selects a month of intraday history. Intraday only.
+	Month      YearMonth
+	OutputSize OutputSize
+	// Adjusted requests split/dividend-adjusted intraday bars (the API
+	// default) when nil or true, and raw bars when false. Intraday only.
+	Adjusted *bool
+	// ExtendedHours includes pre- and post-market bars (the API default) when
+	// nil or true, and regular hours only when false. Intraday only.
+	ExtendedHours *bool
+	// Entitlement selects realtime or 15-minute delayed data for premium keys.
+	Entitlement Entitlement
+}
+
+// Entitlement selects the data feed for premium keys with US market data access.
+type Entitlement string
+
+const (
+	EntitlementRealtime Entitlement = "realtime"
+	EntitlementDelayed  Entitlement = "delayed"
+)
+
+// QuoteParams represents the parameters for the GLOBAL_QUOTE endpoint.
+type QuoteParams struct {
+	Symbol      string
+	Entitlement Entitlement
+}
+
+// Bool returns a pointer to v, for optional parameters such as TimeSeriesParams.Adjusted.
+func Bool(v bool) *bool {
+	return &v
 }
 
 // OHLCV represents the Open, High, Low, Close, and Volume data for a given timestamp.
@@ -378,6 +407,23 @@ func (q *Quote) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	// Delayed entitlement responses use a suffixed key such as
+	// "Global Quote - DATA DELAYED BY 15 MINUTES".
+	if aux.RawQuote == nil {
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		for key, value := range raw {
+			if strings.HasPrefix(key, "Global Quote") {
+				if err := json.Unmarshal(value, &aux.RawQuote); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+
 	// Map each value from RawQuote to its corresponding field in the Quote struct
 	q.Symbol = aux.RawQuote["01. symbol"]
 